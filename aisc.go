@@ -0,0 +1,264 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// Grade - grade of bolt in according to ASTM F3125
+type Grade string
+
+// Typical grades of bolts
+const (
+	A325 Grade = "A325"
+	A490 Grade = "A490"
+)
+
+// GetGradeList - list of all allowable ASTM F3125 grades
+func GetGradeList() []Grade {
+	return []Grade{A325, A490}
+}
+
+func (g Grade) String() string {
+	return fmt.Sprintf("ASTM F3125 Grade %s", string(g))
+}
+
+// Table of nominal stress Fnt and Fnv in according to table J3.2 AISC 360-16
+// and minimum tensile strength Fu in according to ASTM F3125.
+// unit: Pa
+var (
+	aiscFnt = map[Grade]Stress{
+		A325: 620.e6,
+		A490: 780.e6,
+	}
+	aiscFnvN = map[Grade]Stress{
+		A325: 372.e6,
+		A490: 469.e6,
+	}
+	aiscFnvX = map[Grade]Stress{
+		A325: 469.e6,
+		A490: 579.e6,
+	}
+	aiscFu = map[Grade]Stress{
+		A325: 830.e6,
+		A490: 1040.e6,
+	}
+)
+
+// Method - design method of AISC 360-16
+type Method bool
+
+// Constants
+const (
+	LRFD Method = false
+	ASD         = true
+)
+
+func (m Method) String() string {
+	if m { // == ASD
+		return "ASD"
+	}
+	return "LRFD"
+}
+
+// Hole - type of bolt hole in according to table J3.3 AISC 360-16
+type Hole int
+
+// Constants
+const (
+	StandardHole Hole = iota
+	OversizedHole
+	ShortSlottedHole
+	LongSlottedHole
+)
+
+func (h Hole) String() string {
+	switch h {
+	case OversizedHole:
+		return "oversized hole"
+	case ShortSlottedHole:
+		return "short-slotted hole"
+	case LongSlottedHole:
+		return "long-slotted hole"
+	}
+	return "standard hole"
+}
+
+// FactorDu - ratio of the mean installed bolt pretension to the specified
+// minimum bolt pretension in according to J3.8 AISC 360-16
+const FactorDu Factor = 1.13
+
+// AISC - bolt in according to chapter J3 AISC 360-16.
+// Position ThreadShear is thread condition N (threads are not excluded
+// from shear planes) and UnthreadShear is condition X.
+// Connection is slip-critical if mean slip coefficient Mu is not zero.
+type AISC struct {
+	D        Diameter
+	Grade    Grade
+	Position PositionShear
+	Method   Method
+
+	Mu      Factor // mean slip coefficient, 0.30 for class A and 0.50 for class B surfaces
+	Fillers int    // amount of fillers between connected parts
+	Hole    Hole   // type of bolt hole
+	Ns      int    // amount of slip planes
+	Units   Units  // units of report, SI units if empty
+}
+
+// Ab - nominal unthreaded body area of bolt
+func (a AISC) Ab() Area {
+	return AreaA{Dia: a.D}.Value()
+}
+
+// Fnt - nominal tensile stress in according to table J3.2 AISC 360-16
+func (a AISC) Fnt() Stress {
+	return aiscFnt[a.Grade]
+}
+
+// Fnv - nominal shear stress in according to table J3.2 AISC 360-16
+func (a AISC) Fnv() Stress {
+	if a.Position == UnthreadShear {
+		return aiscFnvX[a.Grade]
+	}
+	return aiscFnvN[a.Grade]
+}
+
+// Tb - minimum bolt pretension in according to table J3.1 AISC 360-16,
+// equal to 0.70 times minimum tensile strength of bolt
+func (a AISC) Tb() Force {
+	return Force(0.7 * float64(aiscFu[a.Grade]) * float64(AreaAs{Dia: a.D}.Value()))
+}
+
+// available - return available strength φRn for LRFD or Rn/Ω for ASD
+func (a AISC) available(rn float64, φ, Ω Factor) Force {
+	if a.Method == ASD {
+		return Force(rn / float64(Ω))
+	}
+	return Force(rn * float64(φ))
+}
+
+// TensionStrength - available tensile strength of bolt
+// in according to J3.6 AISC 360-16
+func (a AISC) TensionStrength() Force {
+	return a.available(float64(a.Fnt())*float64(a.Ab()), 0.75, 2.00)
+}
+
+// ShearStrength - available shear strength of bolt for one shear plane
+// in according to J3.6 AISC 360-16
+func (a AISC) ShearStrength() Force {
+	return a.available(float64(a.Fnv())*float64(a.Ab()), 0.75, 2.00)
+}
+
+// Fnt1 - nominal tensile stress modified to include the effects of
+// required shear force Vr in according to J3.7 AISC 360-16.
+// Stress is not less zero for huge shear force.
+func (a AISC) Fnt1(Vr Force) Stress {
+	fnt := float64(a.Fnt())
+	frv := float64(Vr) / float64(a.Ab())
+	k := 1.0 / 0.75
+	if a.Method == ASD {
+		k = 2.00
+	}
+	return Stress(math.Max(0.0, math.Min(fnt, 1.3*fnt-k*fnt/float64(a.Fnv())*frv)))
+}
+
+// CombinedTensionStrength - available tensile strength of bolt subjected
+// to combined tension and shear Vr in according to J3.7 AISC 360-16
+func (a AISC) CombinedTensionStrength(Vr Force) Force {
+	return a.available(float64(a.Fnt1(Vr))*float64(a.Ab()), 0.75, 2.00)
+}
+
+// BearingStrength - available bearing and tearout strength at bolt hole
+// when deformation at the bolt hole at service load is a design
+// consideration in according to J3.10 AISC 360-16.
+// t - thickness of connected material,
+// Fu - specified minimum tensile strength of connected material,
+// lc - clear distance in direction of force between edge of hole and
+// edge of adjacent hole or edge of material
+func (a AISC) BearingStrength(t Dimension, Fu Stress, lc Dimension) Force {
+	bearing := 2.4 * float64(a.D) * float64(t) * float64(Fu)
+	tearout := 1.2 * float64(lc) * float64(t) * float64(Fu)
+	return a.available(math.Min(bearing, tearout), 0.75, 2.00)
+}
+
+// SlipStrength - available slip resistance of bolt in according to
+// J3.8 AISC 360-16 without tension
+func (a AISC) SlipStrength() Force {
+	hf := 1.0
+	if 1 < a.Fillers {
+		hf = 0.85
+	}
+	φ, Ω := Factor(1.00), Factor(1.50)
+	switch a.Hole {
+	case OversizedHole, ShortSlottedHole:
+		φ, Ω = 0.85, 1.76
+	case LongSlottedHole:
+		φ, Ω = 0.70, 2.14
+	}
+	rn := float64(a.Mu) * float64(FactorDu) * hf * float64(a.Tb()) * float64(a.Ns)
+	return a.available(rn, φ, Ω)
+}
+
+// Ksc - factor for combined tension and shear in slip-critical connection
+// in according to J3.9 AISC 360-16.
+// Tr - required tension force per bolt
+func (a AISC) Ksc(Tr Force) Factor {
+	k := 1.0
+	if a.Method == ASD {
+		k = 1.5
+	}
+	return Factor(math.Max(0.0, 1.0-k*float64(Tr)/(float64(FactorDu)*float64(a.Tb()))))
+}
+
+// Validate - return error for not valid slip-critical connection
+func (a AISC) Validate() error {
+	if a.Mu != 0.0 && a.Ns <= 0 {
+		return fmt.Errorf("amount of slip planes %d is not positive for slip-critical connection", a.Ns)
+	}
+	return nil
+}
+
+// Value - return result of bolt calculation on required shear force Vr
+// and tension force Tr per bolt (Vu, Tu for LRFD and Va, Ta for ASD).
+// Not valid connection is failed with infinity factor.
+func (a AISC) Value(Vr, Tr Force, view ViewResult) (_ Factor, s string) {
+	u := a.Units.get()
+	if err := a.Validate(); err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of bolt %s %s is not valid: %v\n", a.D, a.Grade, err)
+	}
+	if view == FullView {
+		s += u.Sprintf("Calculation of bolt %s %s by %s in according to AISC 360-16:\n", a.D, a.Grade, a.Method)
+		s += u.Sprintf("\tAb  = %s\n", a.Ab())
+		s += u.Sprintf("\tFnt = %s\n", a.Fnt())
+		s += u.Sprintf("\tFnv = %s - %s\n", a.Fnv(), a.Position)
+	}
+	max := 0.0
+
+	f1 := ratio(Vr, a.ShearStrength())
+	if view == FullView {
+		s += u.Sprintf("\tShear strength is %s, factor %s\n", a.ShearStrength(), Factor(f1))
+	}
+	max = math.Max(max, f1)
+
+	f2 := ratio(Tr, a.CombinedTensionStrength(Vr))
+	if view == FullView {
+		s += u.Sprintf("\tF'nt = %s\n", a.Fnt1(Vr))
+		s += u.Sprintf("\tTension strength is %s, factor %s\n", a.CombinedTensionStrength(Vr), Factor(f2))
+	}
+	max = math.Max(max, f2)
+
+	if a.Mu != 0.0 {
+		rs := Force(float64(a.Ksc(Tr)) * float64(a.SlipStrength()))
+		f3 := ratio(Vr, rs)
+		if view == FullView {
+			s += u.Sprintf("\tTb  = %s\n", a.Tb())
+			s += u.Sprintf("\tksc = %s\n", a.Ksc(Tr))
+			s += u.Sprintf("\tSlip resistance is %s, factor %s\n", rs, Factor(f3))
+		}
+		max = math.Max(max, f3)
+	}
+	if view == FullView {
+		s += u.Sprintf("Summary factor of combined loads is %s\n", Factor(max))
+	}
+	return Factor(max), s
+}
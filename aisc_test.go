@@ -0,0 +1,107 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleAISC() {
+	a := bolt.AISC{
+		D:        bolt.D20,
+		Grade:    bolt.A325,
+		Position: bolt.ThreadShear,
+		Method:   bolt.LRFD,
+		Mu:       0.30,
+		Hole:     bolt.StandardHole,
+		Ns:       1,
+	}
+	_, s := a.Value(35e3, 30e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+	fmt.Fprintf(os.Stdout, "Bearing strength is %s\n", a.BearingStrength(10e-3, 400e6, 30e-3))
+
+	// Output:
+	// Calculation of bolt HM20 ASTM F3125 Grade A325 by LRFD in according to AISC 360-16:
+	// 	Ab  = 314.2 mm²
+	// 	Fnt = 620.0 MPa
+	// 	Fnv = 372.0 MPa - Shear plane passes through the threaded portion of the bolt
+	// 	Shear strength is 87.7 kN, factor 0.399
+	// 	F'nt = 558.4 MPa
+	// 	Tension strength is 131.6 kN, factor 0.228
+	// 	Tb  = 142.3 kN
+	// 	ksc = 0.813
+	// 	Slip resistance is 39.3 kN, factor 0.892
+	// Summary factor of combined loads is 0.892
+	// Bearing strength is 108.0 kN
+}
+
+func TestAISC(t *testing.T) {
+	for _, g := range bolt.GetGradeList() {
+		for _, d := range bolt.GetBoltDiameterList() {
+			lrfd := bolt.AISC{D: d, Grade: g, Method: bolt.LRFD}
+			asd := bolt.AISC{D: d, Grade: g, Method: bolt.ASD}
+			if math.Abs(float64(lrfd.ShearStrength())/float64(asd.ShearStrength())-1.5) > 1e-8 {
+				t.Errorf("Not valid ratio of LRFD and ASD for %s", g)
+			}
+			if lrfd.CombinedTensionStrength(0.0) != lrfd.TensionStrength() {
+				t.Errorf("Tension strength is not same without shear")
+			}
+			if f, _ := lrfd.Value(0.0, 0.0, bolt.NoView); float64(f) != 0.0 {
+				t.Errorf("Factor can not be not zero if load is zero")
+			}
+			if f, _ := asd.Value(1e10, 1e10, bolt.NoView); float64(f) < 1.0 {
+				t.Errorf("Factor can not be less 1.0 if load is huge")
+			}
+		}
+	}
+}
+
+func TestAISCNotValid(t *testing.T) {
+	a := bolt.AISC{D: bolt.D3o4in, Grade: bolt.A325, Method: bolt.LRFD, Mu: 0.30}
+	if err := a.Validate(); err == nil {
+		t.Errorf("slip-critical connection without slip planes is valid")
+	}
+	if f, s := a.Value(10e3, 0.0, bolt.NoView); !math.IsInf(float64(f), 1) || s == "" {
+		t.Errorf("slip-critical connection without slip planes is passed: %v", f)
+	}
+	// huge shear force
+	a.Ns = 1
+	if fnt := a.Fnt1(1e8); fnt != 0.0 {
+		t.Errorf("modified tensile stress is not zero: %s", fnt)
+	}
+	if f, _ := a.Value(1e8, 10e3, bolt.NoView); !math.IsInf(float64(f), 1) {
+		t.Errorf("bolt without tension strength is passed: %v", f)
+	}
+	// pretension is lost by tension force
+	if f, _ := a.Value(10e3, 1e8, bolt.NoView); math.IsNaN(float64(f)) || float64(f) < 1.0 {
+		t.Errorf("slip-critical connection without slip resistance is passed: %v", f)
+	}
+	if f, _ := a.Value(0.0, 0.0, bolt.NoView); f != 0.0 {
+		t.Errorf("factor is not zero without load: %v", f)
+	}
+}
+
+func TestAISCPretension(t *testing.T) {
+	// table J3.1 AISC 360-16, unit: kips
+	const kip = 4448.2216
+	for _, c := range []struct {
+		d  bolt.Diameter
+		g  bolt.Grade
+		tb float64
+	}{
+		{d: bolt.D1o2in, g: bolt.A325, tb: 12},
+		{d: bolt.D3o4in, g: bolt.A325, tb: 28},
+		{d: bolt.D1in, g: bolt.A325, tb: 51},
+		{d: bolt.D1p1o2in, g: bolt.A325, tb: 118},
+		{d: bolt.D3o4in, g: bolt.A490, tb: 35},
+		{d: bolt.D1p1o4in, g: bolt.A490, tb: 102},
+	} {
+		a := bolt.AISC{D: c.d, Grade: c.g}
+		if tb := float64(a.Tb()) / kip; math.Abs(tb-c.tb) > 0.6 {
+			t.Errorf("Not valid pretension for %s %s: %.2f != %.0f kips", c.d, c.g, tb, c.tb)
+		}
+	}
+}
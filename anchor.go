@@ -0,0 +1,365 @@
+package bolt
+
+import "math"
+
+// Concrete - condition of concrete for fastenings
+type Concrete bool
+
+// Constants
+const (
+	CrackedConcrete   Concrete = false
+	UncrackedConcrete          = true
+)
+
+func (c Concrete) String() string {
+	if c { // == UncrackedConcrete
+		return "uncracked concrete"
+	}
+	return "cracked concrete"
+}
+
+// Anchor - rectangular group of cast-in headed anchor bolts in according to
+// EN1992-4. Anchors are loaded by tension and shear force, the shear force
+// is directed to edge C1.
+// Edge distance equal or less zero is interpreted as no edge.
+// Unit - meter, Pa
+type Anchor struct {
+	B   Bolt      // anchor bolt
+	Hef Dimension // effective embedment depth
+	Dh  Dimension // diameter of anchor head
+	H   Dimension // thickness of concrete member
+
+	Hmin Dimension // minimal thickness of concrete member, factor ψh,sp is 1.0 if zero
+
+	Fck      Stress   // characteristic cylinder strength of concrete
+	Concrete Concrete // condition of concrete
+
+	N1 int       // amount of anchors in direction 1
+	S1 Dimension // spacing of anchors in direction 1
+	N2 int       // amount of anchors in direction 2
+	S2 Dimension // spacing of anchors in direction 2
+
+	C1  Dimension // edge distance in direction of shear load
+	C1n Dimension // edge distance opposite to direction of shear load
+	C2  Dimension // edge distance in direction 2
+	C2n Dimension // edge distance opposite to direction 2
+
+	EN Dimension // eccentricity of tension load in group
+	EV Dimension // eccentricity of shear load in group
+
+	NA    NationalAnnex // national annex, recommended values if empty
+	Units Units         // units of report, SI units if empty
+}
+
+// n - amount of anchors in group
+func (a Anchor) n() int {
+	return a.N1 * a.N2
+}
+
+// edge - return edge distance limited by value lim.
+// unit: mm
+func edge(c Dimension, lim float64) float64 {
+	if c <= 0.0 {
+		return lim
+	}
+	return math.Min(float64(c)*1e3, lim)
+}
+
+// minEdge - return minimal edge distance or zero if no edges.
+// unit: mm
+func minEdge(cs ...Dimension) (min float64) {
+	for _, c := range cs {
+		if c <= 0.0 {
+			continue
+		}
+		if min == 0.0 || float64(c)*1e3 < min {
+			min = float64(c) * 1e3
+		}
+	}
+	return
+}
+
+// projected - length of projected area in one direction with limited
+// edge distance ccr and spacing scr.
+// unit: mm
+func projected(cn, c Dimension, n int, s Dimension, ccr, scr float64) float64 {
+	return edge(cn, ccr) + float64(n-1)*math.Min(float64(s)*1e3, scr) + edge(c, ccr)
+}
+
+// hef - effective embedment depth.
+// unit: mm
+func (a Anchor) hef() float64 {
+	return float64(a.Hef) * 1e3
+}
+
+// fck - characteristic cylinder strength of concrete.
+// unit: MPa
+func (a Anchor) fck() float64 {
+	return float64(a.Fck) * 1e-6
+}
+
+// ah - load bearing area of the head of anchor.
+// unit: mm²
+func (a Anchor) ah() float64 {
+	dh := float64(a.Dh) * 1e3
+	d := float64(a.B.D()) * 1e3
+	return math.Pi / 4.0 * (dh*dh - d*d)
+}
+
+// nRkC - characteristic resistance of concrete cone of group with
+// characteristic spacing scr in according to 7.2.1.4 EN1992-4.
+// unit: N
+func (a Anchor) nRkC(n0, scr float64) float64 {
+	ccr := scr / 2.0
+	acN := projected(a.C1n, a.C1, a.N1, a.S1, ccr, scr) *
+		projected(a.C2n, a.C2, a.N2, a.S2, ccr, scr)
+	a0cN := scr * scr
+	ψsN := 1.0
+	if c := minEdge(a.C1, a.C1n, a.C2, a.C2n); 0.0 < c {
+		ψsN = math.Min(1.0, 0.7+0.3*c/ccr)
+	}
+	ψreN := math.Min(1.0, 0.5+a.hef()/200.0)
+	ψecN := 1.0 / (1.0 + 2.0*float64(a.EN)*1e3/scr)
+	return n0 * acN / a0cN * ψsN * ψreN * ψecN
+}
+
+// n0RkC - characteristic resistance of a single anchor in concrete cone
+// in according to 7.2.1.4 EN1992-4.
+// unit: N
+func (a Anchor) n0RkC() float64 {
+	k1 := 8.9
+	if a.Concrete == UncrackedConcrete {
+		k1 = 12.7
+	}
+	return k1 * math.Sqrt(a.fck()) * math.Pow(a.hef(), 1.5)
+}
+
+// NRdS - design steel resistance of group on tension
+func (a Anchor) NRdS() Force {
+	return Force(float64(a.n()) * float64(TensionResistance{NA: a.NA, B: a.B, BT: UsuallyBolt}.Value()))
+}
+
+// NRdC - design resistance of group to concrete cone failure
+// in according to 7.2.1.4 EN1992-4
+func (a Anchor) NRdC() Force {
+	return Force(a.nRkC(a.n0RkC(), 3.0*a.hef()) / float64(a.NA.get().FactorγMc))
+}
+
+// NRdP - design resistance of group to pull-out failure
+// in according to 7.2.1.5 EN1992-4
+func (a Anchor) NRdP() Force {
+	return Force(float64(a.n()) * a.nRkP() / float64(a.NA.get().FactorγMc))
+}
+
+// nRkP - characteristic pull-out resistance of a single anchor.
+// unit: N
+func (a Anchor) nRkP() float64 {
+	k2 := 7.5
+	if a.Concrete == UncrackedConcrete {
+		k2 = 10.5
+	}
+	return k2 * a.ah() * a.fck()
+}
+
+// ccrSp - characteristic edge distance for splitting.
+// unit: mm
+func (a Anchor) ccrSp() float64 {
+	hef := a.hef()
+	h := float64(a.H) * 1e3
+	switch {
+	case 2.0*hef <= h:
+		return 1.5 * hef
+	case h <= 1.3*hef:
+		return 2.5 * hef
+	}
+	return hef * (2.5 - (h/hef-1.3)/0.7)
+}
+
+// NRdSp - design resistance of group to splitting failure in according
+// to 7.2.1.7 EN1992-4. Return zero if splitting failure may be neglected.
+func (a Anchor) NRdSp() Force {
+	ccr := a.ccrSp()
+	h := float64(a.H) * 1e3
+	c := minEdge(a.C1, a.C1n, a.C2, a.C2n)
+	if (c == 0.0 || 1.2*ccr <= c) && 2.0*a.hef() <= h {
+		return 0.0
+	}
+	n0 := math.Min(a.nRkP(), a.n0RkC())
+	// factor ψh,sp in according to equation (7.23) EN1992-4,
+	// limit by edge distance is not relevant without edge
+	ψhSp := 1.0
+	if hmin := float64(a.Hmin) * 1e3; 0.0 < hmin {
+		ψhSp = math.Min(math.Pow(h/hmin, 2.0/3.0), 2.0)
+		if 0.0 < c {
+			ψhSp = math.Min(ψhSp, math.Max(1.0, math.Pow((a.hef()+1.5*c)/hmin, 2.0/3.0)))
+		}
+	}
+	return Force(a.nRkC(n0, 2.0*ccr) * ψhSp / float64(a.NA.get().FactorγMc))
+}
+
+// NRdCb - design resistance of group to blow-out failure in according
+// to 7.2.1.8 EN1992-4. Only the row of anchors near the edge is
+// considered and result is recalculated to whole group.
+// Return zero if blow-out failure may be neglected.
+func (a Anchor) NRdCb() Force {
+	c1 := minEdge(a.C1, a.C1n, a.C2, a.C2n)
+	if c1 == 0.0 || 0.5*a.hef() < c1 {
+		return 0.0
+	}
+	// row of anchors along the nearest edge
+	nRow, s, cn, c := a.N2, a.S2, a.C2n, a.C2
+	if c1 == minEdge(a.C2, a.C2n) {
+		nRow, s, cn, c = a.N1, a.S1, a.C1n, a.C1
+	}
+	k5 := 8.7
+	if a.Concrete == UncrackedConcrete {
+		k5 = 12.2
+	}
+	n0 := k5 * c1 * math.Sqrt(a.ah()) * math.Sqrt(a.fck())
+	f := float64(a.H)*1e3 - a.hef()
+	acNb := projected(cn, c, nRow, s, 2.0*c1, 4.0*c1) * (2.0*c1 + math.Min(f, 2.0*c1))
+	a0cNb := 16.0 * c1 * c1
+	ψsNb := 1.0
+	if c2 := minEdge(cn, c); 0.0 < c2 {
+		ψsNb = math.Min(1.0, 0.7+0.3*c2/(2.0*c1))
+	}
+	ψgNb := math.Max(1.0, math.Sqrt(float64(nRow))+
+		(1.0-math.Sqrt(float64(nRow)))*math.Min(float64(s)*1e3, 4.0*c1)/(4.0*c1))
+	nRkCb := n0 * acNb / a0cNb * ψsNb * ψgNb
+	return Force(nRkCb / float64(a.NA.get().FactorγMc) * float64(a.n()) / float64(nRow))
+}
+
+// VRdS - design steel resistance of group on shear
+func (a Anchor) VRdS() Force {
+	return Force(float64(a.n()) * float64(ShearResistance{NA: a.NA, B: a.B, Position: ThreadShear}.Value()))
+}
+
+// VRdCp - design resistance of group to concrete pry-out failure
+// in according to 7.2.2.4 EN1992-4
+func (a Anchor) VRdCp() Force {
+	k8 := 2.0
+	if a.hef() < 60.0 {
+		k8 = 1.0
+	}
+	return Force(k8 * a.nRkC(a.n0RkC(), 3.0*a.hef()) / float64(a.NA.get().FactorγMc))
+}
+
+// VRdC - design resistance of group to concrete edge failure
+// in according to 7.2.2.5 EN1992-4. Only the row of anchors near the
+// edge is considered. Return zero if no edge in direction of load.
+func (a Anchor) VRdC() Force {
+	if a.C1 <= 0.0 {
+		return 0.0
+	}
+	c1 := float64(a.C1) * 1e3
+	h := float64(a.H) * 1e3
+	dnom := float64(a.B.D()) * 1e3
+	lf := math.Min(a.hef(), 12.0*dnom)
+	if 24.0 < dnom {
+		lf = math.Min(a.hef(), math.Max(8.0*dnom, 300.0))
+	}
+	α := 0.1 * math.Pow(lf/c1, 0.5)
+	β := 0.1 * math.Pow(dnom/c1, 0.2)
+	k9 := 1.7
+	if a.Concrete == UncrackedConcrete {
+		k9 = 2.4
+	}
+	v0 := k9 * math.Pow(dnom, α) * math.Pow(lf, β) * math.Sqrt(a.fck()) * math.Pow(c1, 1.5)
+	acV := projected(a.C2n, a.C2, a.N2, a.S2, 1.5*c1, 3.0*c1) * math.Min(h, 1.5*c1)
+	a0cV := 4.5 * c1 * c1
+	ψsV := 1.0
+	if c2 := minEdge(a.C2, a.C2n); 0.0 < c2 {
+		ψsV = math.Min(1.0, 0.7+0.3*c2/(1.5*c1))
+	}
+	ψhV := math.Max(1.0, math.Sqrt(1.5*c1/h))
+	ψecV := 1.0 / (1.0 + 2.0*float64(a.EV)*1e3/(3.0*c1))
+	return Force(v0 * acV / a0cV * ψsV * ψhV * ψecV / float64(a.NA.get().FactorγMc))
+}
+
+// ratio - return ratio of load to resistance. Return infinity for
+// resistance equal zero, if load is not zero.
+func ratio(load, resistance Force) float64 {
+	if load == 0.0 {
+		return 0.0
+	}
+	if resistance == 0.0 {
+		return math.Inf(1)
+	}
+	return float64(load) / float64(resistance)
+}
+
+// Failure modes of anchor group
+const (
+	modeSteelTension = iota
+	modeConcreteCone
+	modePullOut
+	modeSplitting
+	modeBlowOut
+	modeSteelShear
+	modePryOut
+	modeConcreteEdge
+)
+
+// Value - return result of anchor group calculation on tension NEd and
+// shear VEd in according to 7.2.3 EN1992-4
+func (a Anchor) Value(NEd, VEd Force, view ViewResult) (_ Factor, s string) {
+	u := a.Units.get()
+	type mode struct {
+		name   string
+		rd     Force
+		ratio  float64
+		tensed bool
+	}
+	modes := []mode{
+		modeSteelTension: {name: "Steel failure on tension", rd: a.NRdS(), tensed: true},
+		modeConcreteCone: {name: "Concrete cone failure", rd: a.NRdC(), tensed: true},
+		modePullOut:      {name: "Pull-out failure", rd: a.NRdP(), tensed: true},
+		modeSplitting:    {name: "Splitting failure", rd: a.NRdSp(), tensed: true},
+		modeBlowOut:      {name: "Blow-out failure", rd: a.NRdCb(), tensed: true},
+		modeSteelShear:   {name: "Steel failure on shear", rd: a.VRdS()},
+		modePryOut:       {name: "Concrete pry-out failure", rd: a.VRdCp()},
+		modeConcreteEdge: {name: "Concrete edge failure", rd: a.VRdC()},
+	}
+	if view == FullView {
+		s += u.Sprintf("Calculation of anchor group %dx%d of %s in %s:\n", a.N1, a.N2, a.B, a.Concrete)
+		s += u.Sprintf("\tγMc = %s\n", a.NA.get().FactorγMc)
+		s += u.Sprintf("\thef = %s\n", a.Hef)
+	}
+	max := 0.0
+	var βNc, βVc float64
+	for i := range modes {
+		if modes[i].rd == 0.0 {
+			// not relevant failure mode
+			if view == FullView {
+				s += u.Sprintf("\t%s is not relevant\n", modes[i].name)
+			}
+			continue
+		}
+		if modes[i].tensed {
+			modes[i].ratio = ratio(NEd, modes[i].rd)
+		} else {
+			modes[i].ratio = ratio(VEd, modes[i].rd)
+		}
+		switch i {
+		case modeConcreteCone, modePullOut, modeSplitting, modeBlowOut:
+			βNc = math.Max(βNc, modes[i].ratio)
+		case modePryOut, modeConcreteEdge:
+			βVc = math.Max(βVc, modes[i].ratio)
+		}
+		max = math.Max(max, modes[i].ratio)
+		if view == FullView {
+			s += u.Sprintf("\t%s: resistance is %s, factor %s\n",
+				modes[i].name, modes[i].rd, Factor(modes[i].ratio))
+		}
+	}
+	βs := math.Pow(modes[modeSteelTension].ratio, 2) + math.Pow(modes[modeSteelShear].ratio, 2)
+	βc := math.Pow(βNc, 1.5) + math.Pow(βVc, 1.5)
+	max = math.Max(max, math.Max(βs, βc))
+	if view == FullView {
+		s += "\tIn according to table 7.3 EN1992-4:\n"
+		s += u.Sprintf("\tFactor of combined loads for steel failure %s\n", Factor(βs))
+		s += u.Sprintf("\tFactor of combined loads for concrete failure %s\n", Factor(βc))
+		s += u.Sprintf("Summary factor of anchor group is %s\n", Factor(max))
+	}
+	return Factor(max), s
+}
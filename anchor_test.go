@@ -0,0 +1,93 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func anchor() bolt.Anchor {
+	return bolt.Anchor{
+		B:        bolt.New(bolt.D20, bolt.G5p6),
+		Hef:      200e-3,
+		Dh:       40e-3,
+		H:        500e-3,
+		Fck:      25e6,
+		Concrete: bolt.CrackedConcrete,
+		N1:       2,
+		S1:       150e-3,
+		N2:       2,
+		S2:       150e-3,
+		C1:       150e-3,
+	}
+}
+
+func ExampleAnchor() {
+	a := anchor()
+	_, s := a.Value(50e3, 15e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of anchor group 2x2 of HM20Cl5.6 in cracked concrete:
+	// 	γMc = 1.500
+	// 	hef = 200.0 mm
+	// 	Steel failure on tension: resistance is 352.8 kN, factor 0.142
+	// 	Concrete cone failure: resistance is 89.2 kN, factor 0.561
+	// 	Pull-out failure: resistance is 471.2 kN, factor 0.106
+	// 	Splitting failure: resistance is 89.2 kN, factor 0.561
+	// 	Blow-out failure is not relevant
+	// 	Steel failure on shear: resistance is 235.2 kN, factor 0.064
+	// 	Concrete pry-out failure: resistance is 178.3 kN, factor 0.084
+	// 	Concrete edge failure: resistance is 28.0 kN, factor 0.537
+	// 	In according to table 7.3 EN1992-4:
+	// 	Factor of combined loads for steel failure 0.024
+	// 	Factor of combined loads for concrete failure 0.813
+	// Summary factor of anchor group is 0.813
+}
+
+func TestAnchor(t *testing.T) {
+	a := anchor()
+	if f, _ := a.Value(0.0, 0.0, bolt.NoView); float64(f) != 0.0 {
+		t.Errorf("Factor can not be not zero if load is zero")
+	}
+	if f, _ := a.Value(1e10, 1e10, bolt.NoView); float64(f) < 1.0 {
+		t.Errorf("Factor can not be less 1.0 if load is huge")
+	}
+	far := a
+	far.C1 = 0.0
+	if far.NRdC() <= a.NRdC() || far.VRdC() != 0.0 {
+		t.Errorf("Edge must decrease concrete resistance")
+	}
+	near := a
+	near.C2 = 60e-3
+	if near.NRdCb() == 0.0 {
+		t.Errorf("Blow-out failure must be relevant near the edge")
+	}
+}
+
+func TestAnchorSplittingThickness(t *testing.T) {
+	a := anchor()
+	// without minimal thickness factor ψh,sp is 1.0
+	base := a.NRdSp()
+	for _, tc := range []struct {
+		hmin bolt.Dimension
+		ψ    float64
+	}{
+		// ψh,sp = min((500/250)^(2/3), max(1, ((200+1.5*150)/250)^(2/3)))
+		{hmin: 250e-3, ψ: math.Pow(425.0/250.0, 2.0/3.0)},
+		// thickness less minimal reduces resistance
+		{hmin: 600e-3, ψ: math.Pow(500.0/600.0, 2.0/3.0)},
+		// ψh,sp is limited by 2.0 for h much more hmin
+		{hmin: 50e-3, ψ: 2.0},
+	} {
+		a.Hmin = tc.hmin
+		expect := float64(base) * tc.ψ
+		if math.Abs(float64(a.NRdSp())-expect) > 1e-6 {
+			t.Errorf("not valid splitting resistance for hmin = %s: %s, expect %s",
+				tc.hmin, a.NRdSp(), bolt.Force(expect))
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package bolt
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NationalAnnex - profile of nationally determined parameters.
+// Zero fields of profile are interpreted as recommended values of Eurocodes.
+type NationalAnnex struct {
+	Name string // name of profile
+
+	FactorγM0    Factor // resistance of cross-sections
+	FactorγM1    Factor // resistance of members to instability
+	FactorγM2    Factor // resistance of cross-sections in tension to fracture, bolts, plates in bearing
+	FactorγM3    Factor // slip resistance at ultimate limit state
+	FactorγM3ser Factor // slip resistance at serviceability limit state
+	FactorγM7    Factor // preload of high strength bolts
+	FactorγMc    Factor // concrete failure modes of fasteners, EN1992-4
+	FactorγMfi   Factor // material property for fire situation, EN1993-1-2
+
+	FactorανUnthread    Factor // factor αν if shear not by thread of bolt
+	FactorK2            Factor // factor k2 for no-countersunk bolt
+	FactorK2Countersunk Factor // factor k2 for countersunk bolt
+}
+
+// recommended - recommended values of Eurocodes
+var recommended = NationalAnnex{
+	Name:                "EN",
+	FactorγM0:           1.0,
+	FactorγM1:           1.0,
+	FactorγM2:           1.25,
+	FactorγM3:           1.25,
+	FactorγM3ser:        1.1,
+	FactorγM7:           1.1,
+	FactorγMc:           1.5,
+	FactorγMfi:          1.0,
+	FactorανUnthread:    0.6,
+	FactorK2:            0.9,
+	FactorK2Countersunk: 0.63,
+}
+
+// annex - return profile based on recommended values with modified
+// partial factors of EN1993-1-1, EN1993-1-8 and factor k2 of table 3.4
+// EN1993-1-8
+func annex(name string, γM0, γM1, γM2, γM3, γM7, k2 Factor) NationalAnnex {
+	na := recommended
+	na.Name = name
+	na.FactorγM0, na.FactorγM1, na.FactorγM2 = γM0, γM1, γM2
+	na.FactorγM3, na.FactorγM7, na.FactorK2 = γM3, γM7, k2
+	return na
+}
+
+// nationalAnnexes - table of national annex profiles
+var nationalAnnexes = map[string]NationalAnnex{
+	"EN": recommended,
+	// BS EN 1993-1-1 UK NA, BS EN 1993-1-8 UK NA, table 2.1 by EN1993-1-8
+	"UK": annex("UK", 1.0, 1.0, 1.25, 1.25, 1.1, 0.9),
+	// DIN EN 1993-1-1/NA, DIN EN 1993-1-8/NA
+	"DE": annex("DE", 1.0, 1.1, 1.25, 1.25, 1.1, 0.9),
+	// NF EN 1993-1-1/NA, NF EN 1993-1-8/NA
+	"FR": annex("FR", 1.0, 1.0, 1.25, 1.25, 1.1, 0.9),
+	// NEN-EN 1993-1-1/NB, NEN-EN 1993-1-8/NB
+	"NL": annex("NL", 1.0, 1.0, 1.25, 1.25, 1.1, 0.9),
+	// PN-EN 1993-1-1/NA, PN-EN 1993-1-8/NA
+	"PL": annex("PL", 1.0, 1.0, 1.25, 1.25, 1.1, 0.9),
+	// DS/EN 1993-1-1 DK NA, DS/EN 1993-1-8 DK NA, normal control class
+	"DK": annex("DK", 1.1, 1.2, 1.35, 1.25, 1.1, 0.9),
+}
+
+// get - return profile with recommended values for zero fields
+func (na NationalAnnex) get() NationalAnnex {
+	if na == (NationalAnnex{}) {
+		return recommended
+	}
+	for _, f := range []struct {
+		v, r *Factor
+	}{
+		{&na.FactorγM0, &recommended.FactorγM0},
+		{&na.FactorγM1, &recommended.FactorγM1},
+		{&na.FactorγM2, &recommended.FactorγM2},
+		{&na.FactorγM3, &recommended.FactorγM3},
+		{&na.FactorγM3ser, &recommended.FactorγM3ser},
+		{&na.FactorγM7, &recommended.FactorγM7},
+		{&na.FactorγMc, &recommended.FactorγMc},
+		{&na.FactorγMfi, &recommended.FactorγMfi},
+		{&na.FactorανUnthread, &recommended.FactorανUnthread},
+		{&na.FactorK2, &recommended.FactorK2},
+		{&na.FactorK2Countersunk, &recommended.FactorK2Countersunk},
+	} {
+		if *f.v == 0.0 {
+			*f.v = *f.r
+		}
+	}
+	if na.Name == "" {
+		na.Name = recommended.Name
+	}
+	return na
+}
+
+// GetNationalAnnex - return national annex profile by name
+func GetNationalAnnex(name string) (NationalAnnex, error) {
+	na, ok := nationalAnnexes[name]
+	if !ok {
+		return NationalAnnex{}, fmt.Errorf("national annex `%s` is not found", name)
+	}
+	return na, nil
+}
+
+// GetNationalAnnexList - list of names of all national annex profiles
+func GetNationalAnnexList() (names []string) {
+	for name := range nationalAnnexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
+
+// AddNationalAnnex store new national annex profile.
+// Zero fields of profile are replaced by recommended values.
+func AddNationalAnnex(na NationalAnnex) {
+	nationalAnnexes[na.Name] = na.get()
+}
+
+func (na NationalAnnex) String() (s string) {
+	na = na.get()
+	s += fmt.Sprintf("National annex %s:\n", na.Name)
+	s += fmt.Sprintf("\tγM0    = %s\n", na.FactorγM0)
+	s += fmt.Sprintf("\tγM1    = %s\n", na.FactorγM1)
+	s += fmt.Sprintf("\tγM2    = %s\n", na.FactorγM2)
+	s += fmt.Sprintf("\tγM3    = %s\n", na.FactorγM3)
+	s += fmt.Sprintf("\tγM3ser = %s\n", na.FactorγM3ser)
+	s += fmt.Sprintf("\tγM7    = %s\n", na.FactorγM7)
+	s += fmt.Sprintf("\tγMc    = %s\n", na.FactorγMc)
+	s += fmt.Sprintf("\tγM,fi  = %s\n", na.FactorγMfi)
+	s += fmt.Sprintf("\tαν     = %s - unthreaded portion of the bolt\n", na.FactorανUnthread)
+	s += fmt.Sprintf("\tk2     = %s - no-countersunk bolt\n", na.FactorK2)
+	s += fmt.Sprintf("\tk2     = %s - countersunk bolt\n", na.FactorK2Countersunk)
+	return
+}
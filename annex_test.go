@@ -0,0 +1,128 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleNationalAnnex() {
+	na, err := bolt.GetNationalAnnex("DK")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(os.Stdout, "%s", na)
+	b := bolt.New(bolt.D24, bolt.G5p8)
+	sr := bolt.ShearResistance{B: b, Position: bolt.ThreadShear, NA: na}
+	fmt.Fprintf(os.Stdout, "%s\n", sr)
+
+	// Output:
+	// National annex DK:
+	// 	γM0    = 1.100
+	// 	γM1    = 1.200
+	// 	γM2    = 1.350
+	// 	γM3    = 1.250
+	// 	γM3ser = 1.100
+	// 	γM7    = 1.100
+	// 	γMc    = 1.500
+	// 	γM,fi  = 1.000
+	// 	αν     = 0.600 - unthreaded portion of the bolt
+	// 	k2     = 0.900 - no-countersunk bolt
+	// 	k2     = 0.630 - countersunk bolt
+	// Calculation of shear resistance for HM24Cl5.8:
+	// 	γM2 = 1.350
+	// 	αν  = 0.500 - Shear plane passes through the threaded portion of the bolt
+	// 	Fub = 500.0 MPa
+	// 	As  = 352.8 mm²
+	// 	In according to table 3.4 EN1993-1-8:
+	// 	Shear resistance is 65.3 kN
+}
+
+func TestNationalAnnex(t *testing.T) {
+	if _, err := bolt.GetNationalAnnex("Unknown"); err == nil {
+		t.Errorf("Unknown national annex is found")
+	}
+	for _, name := range bolt.GetNationalAnnexList() {
+		if _, err := bolt.GetNationalAnnex(name); err != nil {
+			t.Error(err)
+		}
+	}
+	en, err := bolt.GetNationalAnnex("EN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := bolt.New(bolt.D24, bolt.G8p8)
+	for _, pos := range []bolt.PositionShear{bolt.ThreadShear, bolt.UnthreadShear} {
+		sr := bolt.ShearResistance{B: b, Position: pos}
+		srNA := bolt.ShearResistance{B: b, Position: pos, NA: en}
+		if sr.Value() != srNA.Value() {
+			t.Errorf("Empty national annex is not recommended values")
+		}
+	}
+	custom := en
+	custom.Name = "Custom"
+	custom.FactorγM2 = 2.0 * en.FactorγM2
+	bolt.AddNationalAnnex(custom)
+	na, err := bolt.GetNationalAnnex("Custom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := bolt.TensionResistance{B: b, BT: bolt.UsuallyBolt}
+	trNA := bolt.TensionResistance{B: b, BT: bolt.UsuallyBolt, NA: na}
+	if tr.Value() != 2.0*trNA.Value() {
+		t.Errorf("Partial factor of national annex is not used")
+	}
+}
+
+func TestNationalAnnexSlip(t *testing.T) {
+	b := bolt.New(bolt.D20, bolt.G10p9)
+	na := bolt.NationalAnnex{Name: "Slip", FactorγM3: 2.5, FactorγM7: 2.2, FactorK2: 0.45}
+	sr := bolt.SlipResistance{B: b, Ks: 1.0, N: 1, Mu: 0.5}
+	srNA := bolt.SlipResistance{B: b, Ks: 1.0, N: 1, Mu: 0.5, NA: na}
+	if math.Abs(float64(sr.Value(0.0))-2.0*float64(srNA.Value(0.0))) > 1e-6 {
+		t.Errorf("Factor γM3 of national annex is not used")
+	}
+	if math.Abs(float64(sr.FpCd())-2.0*float64(srNA.FpCd())) > 1e-6 {
+		t.Errorf("Factor γM7 of national annex is not used")
+	}
+	tr := bolt.TensionResistance{B: b}
+	trNA := bolt.TensionResistance{B: b, NA: na}
+	if math.Abs(float64(tr.Value())-2.0*float64(trNA.Value())) > 1e-6 {
+		t.Errorf("Factor k2 of national annex is not used")
+	}
+	if v := (bolt.SlipResistance{B: bolt.New(bolt.D20, bolt.G4p6), Ks: 1.0, N: 1, Mu: 0.5}).Value(0.0); v != 0.0 {
+		t.Errorf("Slip resistance of not preloaded bolt is %s", v)
+	}
+	if v := sr.Value(2.0 * sr.FpC()); v != 0.0 {
+		t.Errorf("Slip resistance of bolt with tension more preload is %s", v)
+	}
+}
+
+func TestNationalAnnexIncomplete(t *testing.T) {
+	na := bolt.NationalAnnex{Name: "Incomplete", FactorγM2: 1.3}
+	bt := bolt.BlockTearing{Ant: 1e-4, Anv: 2e-4, Fy: 235e6, Fu: 360e6}
+	btNA := bt
+	btNA.NA = na
+	if v := btNA.Value(); math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+		t.Fatalf("not valid block tearing resistance: %v", v)
+	}
+	btRe := bt
+	btRe.NA = bolt.NationalAnnex{FactorγM0: 1.0, FactorγM2: 1.3}
+	if btNA.Value() != btRe.Value() {
+		t.Errorf("zero fields are not recommended values")
+	}
+	bolt.AddNationalAnnex(na)
+	stored, err := bolt.GetNationalAnnex("Incomplete")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.FactorγM0 == 0.0 || stored.FactorγM7 == 0.0 || stored.FactorK2 == 0.0 {
+		t.Errorf("zero fields of stored profile: %#v", stored)
+	}
+	if stored.FactorγM2 != na.FactorγM2 {
+		t.Errorf("modified factor is lost")
+	}
+}
@@ -0,0 +1,236 @@
+package bolt
+
+import "fmt"
+
+// Standard - standard of fastener component
+type Standard string
+
+// Standards of fastener components
+const (
+	ISO4014   Standard = "ISO 4014"   // hexagon head bolts, partly threaded
+	ISO4017   Standard = "ISO 4017"   // hexagon head screws, fully threaded
+	ISO4032   Standard = "ISO 4032"   // hexagon nuts
+	ISO7089   Standard = "ISO 7089"   // plain washers, normal series
+	ISO7091   Standard = "ISO 7091"   // plain washers, product grade C
+	EN14399p3 Standard = "EN 14399-3" // system HR, bolts and nuts
+	EN14399p4 Standard = "EN 14399-4" // system HV, bolts and nuts
+	EN14399p6 Standard = "EN 14399-6" // plain chamfered washers for systems HR and HV
+)
+
+// Hexagon - dimensions of hexagon head of bolt or hexagon nut
+type Hexagon struct {
+	S Dimension // width across flats
+	E Dimension // width across corners
+	K Dimension // height of head or nut
+}
+
+// Dm - return mean of the across points and across flats dimensions
+// in according to table 3.4 EN1993-1-8
+func (h Hexagon) Dm() Dimension {
+	return (h.S + h.E) / 2.0
+}
+
+func (h Hexagon) String() string {
+	return fmt.Sprintf("s = %s, e = %s, k = %s", h.S, h.E, h.K)
+}
+
+// Washer - dimensions of washer
+type Washer struct {
+	D1 Dimension // inner diameter
+	D2 Dimension // outer diameter
+	H  Dimension // thickness
+}
+
+func (w Washer) String() string {
+	return fmt.Sprintf("d1 = %s, d2 = %s, h = %s", w.D1, w.D2, w.H)
+}
+
+// hexagon - return hexagon dimensions in millimeters
+func hexagon(s, e, k float64) Hexagon {
+	return Hexagon{S: Dimension(s * 1e-3), E: Dimension(e * 1e-3), K: Dimension(k * 1e-3)}
+}
+
+// washer - return washer dimensions in millimeters
+func washer(d1, d2, h float64) Washer {
+	return Washer{D1: Dimension(d1 * 1e-3), D2: Dimension(d2 * 1e-3), H: Dimension(h * 1e-3)}
+}
+
+// isoHead - heads of bolts in according to ISO 4014 and ISO 4017
+var isoHead = map[Diameter]Hexagon{
+	D12: hexagon(18, 20.03, 7.5),
+	D16: hexagon(24, 26.75, 10),
+	D20: hexagon(30, 33.53, 12.5),
+	D24: hexagon(36, 39.98, 15),
+	D30: hexagon(46, 50.85, 18.7),
+	D36: hexagon(55, 60.79, 22.5),
+	D42: hexagon(65, 71.3, 26),
+	D48: hexagon(75, 82.6, 30),
+}
+
+// heads - table of bolt heads
+var heads = map[Standard]map[Diameter]Hexagon{
+	ISO4014: isoHead,
+	ISO4017: isoHead,
+	EN14399p3: {
+		D12: hexagon(22, 23.91, 7.5),
+		D16: hexagon(27, 29.56, 10),
+		D20: hexagon(32, 35.03, 12.5),
+		D24: hexagon(41, 45.2, 15),
+		D30: hexagon(50, 55.37, 18.7),
+		D36: hexagon(60, 66.44, 22.5),
+	},
+	EN14399p4: {
+		D12: hexagon(22, 23.91, 8),
+		D16: hexagon(27, 29.56, 10),
+		D20: hexagon(32, 35.03, 13),
+		D24: hexagon(41, 45.2, 15),
+		D30: hexagon(50, 55.37, 19),
+		D36: hexagon(60, 66.44, 23),
+	},
+}
+
+// nuts - table of nuts
+var nuts = map[Standard]map[Diameter]Hexagon{
+	ISO4032: {
+		D12: hexagon(18, 20.03, 10.8),
+		D16: hexagon(24, 26.75, 14.8),
+		D20: hexagon(30, 32.95, 18),
+		D24: hexagon(36, 39.55, 21.5),
+		D30: hexagon(46, 50.85, 25.6),
+		D36: hexagon(55, 60.79, 31),
+		D42: hexagon(65, 71.3, 34),
+		D48: hexagon(75, 82.6, 38),
+	},
+	EN14399p3: {
+		D12: hexagon(22, 23.91, 10.8),
+		D16: hexagon(27, 29.56, 14.8),
+		D20: hexagon(32, 35.03, 18),
+		D24: hexagon(41, 45.2, 21.5),
+		D30: hexagon(50, 55.37, 25.6),
+		D36: hexagon(60, 66.44, 31),
+	},
+	EN14399p4: {
+		D12: hexagon(22, 23.91, 10),
+		D16: hexagon(27, 29.56, 13),
+		D20: hexagon(32, 35.03, 16),
+		D24: hexagon(41, 45.2, 20),
+		D30: hexagon(50, 55.37, 24),
+		D36: hexagon(60, 66.44, 29),
+	},
+}
+
+// washers - table of washers
+var washers = map[Standard]map[Diameter]Washer{
+	ISO7089: {
+		D12: washer(13, 24, 2.5),
+		D16: washer(17, 30, 3),
+		D20: washer(21, 37, 3),
+		D24: washer(25, 44, 4),
+		D30: washer(31, 56, 4),
+		D36: washer(37, 66, 5),
+		D42: washer(45, 78, 8),
+		D48: washer(52, 92, 8),
+	},
+	ISO7091: {
+		D12: washer(13.5, 24, 2.5),
+		D16: washer(17.5, 30, 3),
+		D20: washer(22, 37, 3),
+		D24: washer(26, 44, 4),
+		D30: washer(33, 56, 4),
+		D36: washer(39, 66, 5),
+		D42: washer(45, 78, 8),
+		D48: washer(52, 92, 8),
+	},
+	EN14399p6: {
+		D12: washer(13, 24, 3),
+		D16: washer(17, 30, 4),
+		D20: washer(21, 37, 4),
+		D24: washer(25, 44, 4),
+		D30: washer(31, 56, 5),
+		D36: washer(37, 66, 6),
+	},
+}
+
+// GetHead - return dimensions of bolt head
+func GetHead(std Standard, bd Diameter) (Hexagon, error) {
+	h, ok := heads[std][bd]
+	if !ok {
+		return Hexagon{}, fmt.Errorf("head of bolt %s in according to %s is not found", bd, std)
+	}
+	return h, nil
+}
+
+// GetNut - return dimensions of nut
+func GetNut(std Standard, bd Diameter) (Hexagon, error) {
+	h, ok := nuts[std][bd]
+	if !ok {
+		return Hexagon{}, fmt.Errorf("nut for bolt %s in according to %s is not found", bd, std)
+	}
+	return h, nil
+}
+
+// GetWasher - return dimensions of washer
+func GetWasher(std Standard, bd Diameter) (Washer, error) {
+	w, ok := washers[std][bd]
+	if !ok {
+		return Washer{}, fmt.Errorf("washer for bolt %s in according to %s is not found", bd, std)
+	}
+	return w, nil
+}
+
+// Assembly - fastener assembly of bolt, nuts and washers
+type Assembly struct {
+	B       Bolt
+	Bolt    Standard // standard of bolt
+	Nut     Standard // standard of nuts
+	Nuts    int      // amount of nuts
+	Washer  Standard // standard of washers
+	Washers int      // amount of washers
+}
+
+// Components - return dimensions of components of assembly
+func (a Assembly) Components() (head, nut Hexagon, w Washer, err error) {
+	if head, err = GetHead(a.Bolt, a.B.D()); err != nil {
+		return
+	}
+	if 0 < a.Nuts {
+		if nut, err = GetNut(a.Nut, a.B.D()); err != nil {
+			return
+		}
+	}
+	if 0 < a.Washers {
+		if w, err = GetWasher(a.Washer, a.B.D()); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Dm - return minimal mean of the across points and across flats
+// dimensions of bolt head or nut in according to table 3.4 EN1993-1-8
+func (a Assembly) Dm() (Dimension, error) {
+	head, nut, _, err := a.Components()
+	if err != nil {
+		return 0.0, err
+	}
+	if 0 < a.Nuts && nut.Dm() < head.Dm() {
+		return nut.Dm(), nil
+	}
+	return head.Dm(), nil
+}
+
+func (a Assembly) String() (s string) {
+	head, nut, w, err := a.Components()
+	if err != nil {
+		return fmt.Sprintf("Error: %v", err)
+	}
+	s += fmt.Sprintf("Assembly of bolt %s:\n", a.B)
+	s += fmt.Sprintf("\tbolt %s: %s\n", a.Bolt, head)
+	if 0 < a.Nuts {
+		s += fmt.Sprintf("\t%d nut %s: %s\n", a.Nuts, a.Nut, nut)
+	}
+	if 0 < a.Washers {
+		s += fmt.Sprintf("\t%d washer %s: %s\n", a.Washers, a.Washer, w)
+	}
+	return
+}
@@ -0,0 +1,60 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleAssembly() {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D20, bolt.G10p9),
+		Bolt:    bolt.EN14399p4,
+		Nut:     bolt.EN14399p4,
+		Nuts:    1,
+		Washer:  bolt.EN14399p6,
+		Washers: 2,
+	}
+	fmt.Fprintf(os.Stdout, "%s", a)
+	dm, _ := a.Dm()
+	fmt.Fprintf(os.Stdout, "dm = %s\n", dm)
+
+	// Output:
+	// Assembly of bolt HM20Cl10.9:
+	// 	bolt EN 14399-4: s = 32.0 mm, e = 35.0 mm, k = 13.0 mm
+	// 	1 nut EN 14399-4: s = 32.0 mm, e = 35.0 mm, k = 16.0 mm
+	// 	2 washer EN 14399-6: d1 = 21.0 mm, d2 = 37.0 mm, h = 4.0 mm
+	// dm = 33.5 mm
+}
+
+func TestAssembly(t *testing.T) {
+	for _, bd := range bolt.GetBoltDiameterList() {
+		a := bolt.Assembly{
+			B:       bolt.New(bd, bolt.G8p8),
+			Bolt:    bolt.ISO4014,
+			Nut:     bolt.ISO4032,
+			Nuts:    1,
+			Washer:  bolt.ISO7089,
+			Washers: 1,
+		}
+		head, nut, w, err := a.Components()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !(head.S < head.E && nut.S < nut.E) {
+			t.Errorf("%s: width across flats is more across corners", bd)
+		}
+		if !(float64(bd) < float64(w.D1) && w.D1 < w.D2) {
+			t.Errorf("%s: not valid washer %s", bd, w)
+		}
+	}
+	a := bolt.Assembly{
+		B:    bolt.New(bolt.D48, bolt.G10p9),
+		Bolt: bolt.EN14399p3,
+	}
+	if _, err := a.Dm(); err == nil {
+		t.Errorf("not valid assembly without error")
+	}
+}
@@ -0,0 +1,223 @@
+package bolt
+
+import "math"
+
+// factorβj - the foundation joint material coefficient in according to
+// 6.2.5(7) EN1993-1-8
+const factorβj Factor = 2.0 / 3.0
+
+// BasePlate - column base plate with anchor bolts in according to
+// 6.2.5, 6.2.6 and 6.2.8 EN1993-1-8.
+// Column is symmetrical I-section. Anchor bolts are located outside of
+// column flanges in one row on each side of column.
+// Steel takes precedence over Fy, not valid strength fails with infinity factor.
+// Unit - meter, Pa
+type BasePlate struct {
+	// Plate
+	Width  Dimension // width of plate bp, parallel to column flange
+	Length Dimension // length of plate, parallel to column web
+	Thk    Dimension // thickness of plate tp
+	Steel  Steel     // steel grade of plate
+	Fy     Stress    // yield strength of plate
+
+	// Column
+	Depth       Dimension // depth of column section
+	FlangeWidth Dimension // width of column flange
+	FlangeThk   Dimension // thickness of column flange
+
+	// Concrete
+	Fcd Stress // design compressive strength of concrete
+
+	// Anchor bolts
+	Anchor  Bolt          // anchor bolt
+	Anchors int           // amount of anchor bolts in row on each side of column
+	Mx      Dimension     // distance from anchor axis to face of column flange
+	E       Dimension     // distance from outside anchor axis to plate edge along the row
+	NA      NationalAnnex // national annex, recommended values if empty
+	Units   Units         // units of report, SI units if empty
+}
+
+// Fjd - design bearing strength of the joint in according to 6.2.5(7) EN1993-1-8
+func (bp BasePlate) Fjd() Stress {
+	return Stress(float64(factorβj) * float64(bp.Fcd))
+}
+
+// C - additional bearing width in according to 6.2.5(4) EN1993-1-8
+func (bp BasePlate) C() Dimension {
+	fy, _ := bp.Steel.yield(bp.Thk, bp.Fy)
+	return Dimension(float64(bp.Thk) *
+		math.Sqrt(float64(fy)/(3.0*float64(bp.Fjd())*float64(bp.NA.get().FactorγM0))))
+}
+
+// overhang - distance from face of column flange to plate edge
+func (bp BasePlate) overhang() float64 {
+	return (float64(bp.Length) - float64(bp.Depth)) / 2.0
+}
+
+// FcRd - compression resistance of T-stub under one column flange
+// in according to 6.2.5 and 6.2.6.9 EN1993-1-8
+func (bp BasePlate) FcRd() Force {
+	c := float64(bp.C())
+	beff := float64(bp.FlangeThk) + c + math.Min(c, bp.overhang())
+	leff := math.Min(float64(bp.FlangeWidth)+2.0*c, float64(bp.Width))
+	return Force(float64(bp.Fjd()) * beff * leff)
+}
+
+// Leff - effective length of tension T-stub for anchor bolt row outside
+// of column flange in according to table 6.6 EN1993-1-8
+func (bp BasePlate) Leff() Dimension {
+	mx := float64(bp.Mx)
+	ex := bp.overhang() - mx
+	e := float64(bp.E)
+	circular := math.Min(2.0*math.Pi*mx, math.Pi*mx+2.0*e)
+	nonCircular := math.Min(4.0*mx+1.25*ex, e+2.0*mx+0.625*ex)
+	nonCircular = math.Min(nonCircular, 0.5*float64(bp.Width))
+	if bp.Anchors > 1 {
+		w := (float64(bp.Width) - 2.0*e) / float64(bp.Anchors-1)
+		circular = math.Min(circular, math.Pi*mx+w)
+		nonCircular = math.Min(nonCircular, 0.5*w+2.0*mx+0.625*ex)
+	}
+	return Dimension(math.Min(circular, nonCircular))
+}
+
+// FtRd - tension resistance of T-stub on one side of column
+// in according to 6.2.4 and 6.2.6.11 EN1993-1-8.
+// Prying forces are not developed (table 6.2, modes 1-2 and 3).
+// Only mode 3 is relevant for anchor bolts at face of column flange.
+func (bp BasePlate) FtRd() Force {
+	ft3 := float64(bp.Anchors) *
+		float64(TensionResistance{NA: bp.NA, B: bp.Anchor, BT: UsuallyBolt}.Value())
+	if bp.Mx <= 0.0 {
+		return Force(ft3)
+	}
+	fy, _ := bp.Steel.yield(bp.Thk, bp.Fy)
+	mpl := 0.25 * float64(bp.Leff()) * math.Pow(float64(bp.Thk), 2) *
+		float64(fy) / float64(bp.NA.get().FactorγM0)
+	ft12 := 2.0 * mpl / float64(bp.Mx)
+	return Force(math.Min(ft12, ft3))
+}
+
+// zT - lever arm of anchor bolt row from column axis
+func (bp BasePlate) zT() float64 {
+	return float64(bp.Depth)/2.0 + float64(bp.Mx)
+}
+
+// zC - lever arm of compression T-stub from column axis
+func (bp BasePlate) zC() float64 {
+	return (float64(bp.Depth) - float64(bp.FlangeThk)) / 2.0
+}
+
+// InteractionPoint - point of NRd-MRd interaction diagram.
+// Axial force is positive in tension.
+type InteractionPoint struct {
+	N Force
+	M Moment
+}
+
+func (ip InteractionPoint) String() string {
+	return ip.In(SI())
+}
+
+// In - return interaction point formatted in system of units
+func (ip InteractionPoint) In(u Units) string {
+	return u.Sprintf("NRd = %s, MRd = %s", ip.N, ip.M)
+}
+
+// Interaction - return points of NRd-MRd interaction diagram in according
+// to table 6.7 EN1993-1-8. Points are ordered counterclockwise.
+func (bp BasePlate) Interaction() []InteractionPoint {
+	ft := float64(bp.FtRd())
+	fc := float64(bp.FcRd())
+	zt, zc := bp.zT(), bp.zC()
+	return []InteractionPoint{
+		{N: Force(2.0 * ft), M: 0.0},
+		{N: Force(ft), M: Moment(ft * zt)},
+		{N: Force(ft - fc), M: Moment(ft*zt + fc*zc)},
+		{N: Force(-fc), M: Moment(fc * zc)},
+		{N: Force(-2.0 * fc), M: 0.0},
+		{N: Force(-fc), M: Moment(-fc * zc)},
+		{N: Force(ft - fc), M: Moment(-ft*zt - fc*zc)},
+		{N: Force(ft), M: Moment(-ft * zt)},
+	}
+}
+
+// Value - return factor of base plate utilization for axial force NEd
+// (positive in tension) and bending moment MEd. Factor is found by ray
+// from origin to load point on interaction diagram, pure axial force and
+// pure bending moment are calculated by resistances directly.
+func (bp BasePlate) Value(NEd Force, MEd Moment, view ViewResult) (_ Factor, s string) {
+	u := bp.Units.get()
+	if view == FullView {
+		s += u.Sprintf("%s\n", bp)
+	}
+	if _, err := bp.Steel.yield(bp.Thk, bp.Fy); err != nil {
+		if view == FullView {
+			s += u.Sprintf("Error of plate: %v\n", err)
+		}
+		return Factor(math.Inf(1)), s
+	}
+	n, m := float64(NEd), float64(MEd)
+	ft, fc := float64(bp.FtRd()), float64(bp.FcRd())
+	max := 0.0
+	switch {
+	case n == 0.0 && m == 0.0:
+		// without load
+	case m == 0.0 && 0.0 < n:
+		// pure tension
+		max = n / (2.0 * ft)
+	case m == 0.0:
+		// pure compression
+		max = -n / (2.0 * fc)
+	case n == 0.0:
+		// pure bending moment
+		max = math.Abs(m) / (math.Min(ft, fc) * (bp.zT() + bp.zC()))
+	default:
+		max = bp.ray(n, m)
+	}
+	if view == FullView {
+		s += u.Sprintf("NEd = %s, MEd = %s\n", NEd, MEd)
+		s += u.Sprintf("Summary factor of base plate is %s\n", Factor(max))
+	}
+	return Factor(max), s
+}
+
+// ray - return factor of load point (n, m) as ratio of distances from
+// origin to load point and to border of interaction diagram
+func (bp BasePlate) ray(n, m float64) (max float64) {
+	points := bp.Interaction()
+	for i := range points {
+		a := points[i]
+		b := points[(i+1)%len(points)]
+		dn := float64(b.N - a.N)
+		dm := float64(b.M - a.M)
+		det := dn*m - n*dm
+		if det == 0.0 {
+			continue
+		}
+		λ := (dn*float64(a.M) - dm*float64(a.N)) / det
+		t := (n*float64(a.M) - m*float64(a.N)) / det
+		if λ <= 0.0 || t < 0.0 || 1.0 < t {
+			continue
+		}
+		max = math.Max(max, 1.0/λ)
+	}
+	return
+}
+
+func (bp BasePlate) String() (s string) {
+	u := bp.Units.get()
+	s += u.Sprintf("Calculation of column base plate with anchor bolts %d x %s:\n", bp.Anchors, bp.Anchor)
+	s += u.Sprintf("\tγM0 = %s\n", bp.NA.get().FactorγM0)
+	s += u.Sprintf("\tfjd = %s\n", bp.Fjd())
+	s += u.Sprintf("\tc   = %s\n", bp.C())
+	s += "\tIn according to 6.2.5 EN1993-1-8:\n"
+	s += u.Sprintf("\tCompression resistance of T-stub is %s\n", bp.FcRd())
+	s += u.Sprintf("\tleff = %s\n", bp.Leff())
+	s += "\tIn according to table 6.2 EN1993-1-8:\n"
+	s += u.Sprintf("\tTension resistance of T-stub is %s\n", bp.FtRd())
+	s += "\tIn according to table 6.7 EN1993-1-8 interaction points:\n"
+	for _, p := range bp.Interaction() {
+		s += u.Sprintf("\t%s\n", p)
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func basePlate() bolt.BasePlate {
+	return bolt.BasePlate{
+		Width:       400e-3,
+		Length:      500e-3,
+		Thk:         25e-3,
+		Fy:          235e6,
+		Depth:       300e-3,
+		FlangeWidth: 300e-3,
+		FlangeThk:   19e-3,
+		Fcd:         13.3e6,
+		Anchor:      bolt.New(bolt.D24, bolt.G5p6),
+		Anchors:     2,
+		Mx:          50e-3,
+		E:           50e-3,
+	}
+}
+
+func ExampleBasePlate() {
+	bp := basePlate()
+	fmt.Fprintf(os.Stdout, "%s", bp)
+
+	// Output:
+	// Calculation of column base plate with anchor bolts 2 x HM24Cl5.6:
+	// 	γM0 = 1.000
+	// 	fjd = 8.9 MPa
+	// 	c   = 74.3 mm
+	// 	In according to 6.2.5 EN1993-1-8:
+	// 	Compression resistance of T-stub is 594.5 kN
+	// 	leff = 181.3 mm
+	// 	In according to table 6.2 EN1993-1-8:
+	// 	Tension resistance of T-stub is 254.0 kN
+	// 	In according to table 6.7 EN1993-1-8 interaction points:
+	// 	NRd = 508.0 kN, MRd = 0.0 kN*m
+	// 	NRd = 254.0 kN, MRd = 50.8 kN*m
+	// 	NRd = -340.5 kN, MRd = 134.3 kN*m
+	// 	NRd = -594.5 kN, MRd = 83.5 kN*m
+	// 	NRd = -1189.0 kN, MRd = 0.0 kN*m
+	// 	NRd = -594.5 kN, MRd = -83.5 kN*m
+	// 	NRd = -340.5 kN, MRd = -134.3 kN*m
+	// 	NRd = 254.0 kN, MRd = -50.8 kN*m
+}
+
+func TestBasePlate(t *testing.T) {
+	bp := basePlate()
+	if f, _ := bp.Value(0.0, 0.0, bolt.NoView); float64(f) != 0.0 {
+		t.Errorf("Factor can not be not zero if load is zero")
+	}
+	for _, p := range bp.Interaction() {
+		f, s := bp.Value(p.N, p.M, bolt.FullView)
+		if math.Abs(float64(f)-1.0) > 1e-6 {
+			t.Errorf("Point %s is not on border of interaction diagram: %s\n%s", p, f, s)
+		}
+	}
+	if f, _ := bp.Value(0.0, 1e6, bolt.NoView); float64(f) < 1.0 {
+		t.Errorf("Factor can not be less 1.0 if load is huge")
+	}
+	// pure axial force and pure bending moment
+	ps := bp.Interaction()
+	for _, c := range []struct {
+		n bolt.Force
+		m bolt.Moment
+	}{
+		{n: ps[0].N / 2},
+		{n: ps[4].N / 2},
+	} {
+		if f, s := bp.Value(c.n, c.m, bolt.FullView); math.Abs(float64(f)-0.5) > 1e-6 {
+			t.Errorf("not valid factor for NEd = %s, MEd = %s: %s\n%s", c.n, c.m, f, s)
+		}
+	}
+	for _, sign := range []float64{-1, 1} {
+		// the same factor by interaction diagram with small axial force
+		m := bolt.Moment(sign * 10e3)
+		f, _ := bp.Value(0.0, m, bolt.NoView)
+		fn, _ := bp.Value(1e-6, m, bolt.NoView)
+		if math.Abs(float64(f-fn)) > 1e-6 || f <= 0.0 {
+			t.Errorf("not valid factor for pure bending moment %s: %s != %s", m, f, fn)
+		}
+	}
+	// anchor bolts at face of column flange
+	bp.Mx = 0.0
+	if ft := bp.FtRd(); math.IsNaN(float64(ft)) || ft <= 0.0 {
+		t.Errorf("not valid tension resistance of T-stub: %s", ft)
+	}
+	if f, _ := bp.Value(-10e3, 5e3, bolt.NoView); math.IsNaN(float64(f)) {
+		t.Errorf("not valid factor for anchor bolts at face of column flange")
+	}
+}
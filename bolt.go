@@ -104,7 +104,54 @@ func GetBoltDiameterList() []Diameter {
 	return []Diameter{D12, D16, D20, D24, D30, D36, D42, D48}
 }
 
+// inch - length of one inch.
+// unit: meter
+const inch = 25.4e-3
+
+// Typical imperial bolt diameters with UNC thread
+const (
+	D1o2in   Diameter = 1.0 / 2.0 * inch
+	D5o8in   Diameter = 5.0 / 8.0 * inch
+	D3o4in   Diameter = 3.0 / 4.0 * inch
+	D7o8in   Diameter = 7.0 / 8.0 * inch
+	D1in     Diameter = 1.0 * inch
+	D1p1o8in Diameter = (1.0 + 1.0/8.0) * inch
+	D1p1o4in Diameter = (1.0 + 1.0/4.0) * inch
+	D1p3o8in Diameter = (1.0 + 3.0/8.0) * inch
+	D1p1o2in Diameter = (1.0 + 1.0/2.0) * inch
+)
+
+// GetBoltImperialDiameterList - list of all allowable imperial bolt diameters
+func GetBoltImperialDiameterList() []Diameter {
+	return []Diameter{D1o2in, D5o8in, D3o4in, D7o8in, D1in, D1p1o8in, D1p1o4in, D1p3o8in, D1p1o2in}
+}
+
+// Table of imperial bolt diameter names and amount of UNC threads per inch
+var imperial = map[Diameter]struct {
+	name string
+	tpi  float64
+}{
+	D1o2in:   {name: "1/2", tpi: 13},
+	D5o8in:   {name: "5/8", tpi: 11},
+	D3o4in:   {name: "3/4", tpi: 10},
+	D7o8in:   {name: "7/8", tpi: 9},
+	D1in:     {name: "1", tpi: 8},
+	D1p1o8in: {name: "1-1/8", tpi: 7},
+	D1p1o4in: {name: "1-1/4", tpi: 7},
+	D1p3o8in: {name: "1-3/8", tpi: 6},
+	D1p1o2in: {name: "1-1/2", tpi: 6},
+}
+
+// IsImperial - return true for imperial bolt diameter with UNC thread
+func (bd Diameter) IsImperial() bool {
+	_, ok := imperial[bd]
+	return ok
+}
+
 func (bd Diameter) String() string {
+	if unc, ok := imperial[bd]; ok {
+		return fmt.Sprintf("%s\"-%.0fUNC", unc.name, unc.tpi)
+	}
 	return fmt.Sprintf("HM%.0f", float64(bd)*1e3)
 }
 
@@ -122,6 +169,17 @@ var holeDiameter = map[Diameter]DiameterDimension{
 	D36: 39e-3,
 	D42: 45e-3,
 	D48: 51e-3,
+
+	// standard holes in according to table J3.3 AISC 360-16
+	D1o2in:   (1.0/2.0 + 1.0/16.0) * inch,
+	D5o8in:   (5.0/8.0 + 1.0/16.0) * inch,
+	D3o4in:   (3.0/4.0 + 1.0/16.0) * inch,
+	D7o8in:   (7.0/8.0 + 1.0/16.0) * inch,
+	D1in:     (1.0 + 1.0/8.0) * inch,
+	D1p1o8in: (1.0 + 1.0/8.0 + 1.0/8.0) * inch,
+	D1p1o4in: (1.0 + 1.0/4.0 + 1.0/8.0) * inch,
+	D1p3o8in: (1.0 + 3.0/8.0 + 1.0/8.0) * inch,
+	D1p1o2in: (1.0 + 1.0/2.0 + 1.0/8.0) * inch,
 }
 
 // Value - return value diameter of hole for bolt
@@ -136,7 +194,7 @@ func (hd HoleDiameter) String() string {
 type DiameterDimension float64
 
 func (dia DiameterDimension) String() string {
-	return fmt.Sprintf("Ø%s", Dimension(float64(dia)))
+	return dia.In(SI())
 }
 
 // Table of Fyb.
@@ -207,7 +265,7 @@ func (f Fub) String() string {
 type Stress float64
 
 func (s Stress) String() string {
-	return fmt.Sprintf("%.1f MPa", float64(s)*1.e-6)
+	return s.In(SI())
 }
 
 // Pinch - struct of bolt pinch
@@ -226,8 +284,12 @@ var boltPinch = map[Diameter]Dimension{
 	D48: 5.00e-3,
 }
 
-// Value - return value of bolt pinch
+// Value - return value of bolt pinch.
+// For UNC thread pinch is calculated by amount of threads per inch.
 func (bp Pinch) Value() Dimension {
+	if unc, ok := imperial[bp.Dia]; ok {
+		return Dimension(inch / unc.tpi)
+	}
 	return boltPinch[bp.Dia]
 }
 
@@ -235,7 +297,7 @@ func (bp Pinch) Value() Dimension {
 type Dimension float64
 
 func (d Dimension) String() string {
-	return fmt.Sprintf("%.1f mm", float64(d)*1.e3)
+	return d.In(SI())
 }
 
 // Area - type of area.
@@ -243,7 +305,7 @@ func (d Dimension) String() string {
 type Area float64
 
 func (a Area) String() string {
-	return fmt.Sprintf("%.1f mm\u00B2", float64(a)*1.e6)
+	return a.In(SI())
 }
 
 // AreaAs tension stress area of the bolt
@@ -251,12 +313,18 @@ type AreaAs struct {
 	Dia Diameter
 }
 
-// Value - return value of area As (tension stress area of the bolt)
+// Value - return value of area As (tension stress area of the bolt).
+// For UNC thread area is As = 0.7854(D - 0.9743/n)² in accordance with
+// ASME B1.1.
 func (as AreaAs) Value() Area {
 	var pd = Pinch(as) // Use a type conversion
 	p := float64(pd.Value())
 	dia := float64(as.Dia)
-	return Area(math.Pi / 4. * math.Pow(dia-0.935229*p, 2.0))
+	k := 0.935229
+	if as.Dia.IsImperial() {
+		k = 0.9743
+	}
+	return Area(math.Pi / 4. * math.Pow(dia-k*p, 2.0))
 }
 
 func (as AreaAs) String() string {
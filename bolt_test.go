@@ -140,3 +140,47 @@ func TestDescription(t *testing.T) {
 	}
 	t.Logf("%s", rep)
 }
+
+func TestBoltImperialDiameter(t *testing.T) {
+	for pos, db := range bolt.GetBoltImperialDiameterList() {
+		if !db.IsImperial() {
+			t.Fatalf("Diameter is not imperial: %v", db)
+		}
+		if pos > 0 {
+			last := float64(bolt.GetBoltImperialDiameterList()[pos-1])
+			if last > float64(db) {
+				t.Fatalf("Next diameter is not more")
+			}
+		}
+		b := bolt.New(db, bolt.G8p8)
+		if b.Do().Value() <= bolt.DiameterDimension(b.D()) {
+			t.Fatalf("Hole is not more bolt diameter: %s", b.Do())
+		}
+		if b.As().Value() >= b.A().Value() || b.As().Value() <= 0.0 {
+			t.Fatalf("Not valid tension stress area: %s", b.As())
+		}
+	}
+	for _, db := range bolt.GetBoltDiameterList() {
+		if db.IsImperial() {
+			t.Fatalf("Diameter is imperial: %v", db)
+		}
+	}
+}
+
+func ExampleDiameter() {
+	for _, d := range bolt.GetBoltImperialDiameterList() {
+		b := bolt.New(d, bolt.G8p8)
+		fmt.Fprintf(os.Stdout, "%-12s pinch %s, hole %s, As = %s\n", d, bolt.Pinch{Dia: d}.Value(), b.Do().Value(), b.As().Value())
+	}
+
+	// Output:
+	// 1/2"-13UNC   pinch 2.0 mm, hole Ø14.3 mm, As = 91.5 mm²
+	// 5/8"-11UNC   pinch 2.3 mm, hole Ø17.5 mm, As = 145.8 mm²
+	// 3/4"-10UNC   pinch 2.5 mm, hole Ø20.6 mm, As = 215.8 mm²
+	// 7/8"-9UNC    pinch 2.8 mm, hole Ø23.8 mm, As = 297.9 mm²
+	// 1"-8UNC      pinch 3.2 mm, hole Ø28.6 mm, As = 390.8 mm²
+	// 1-1/8"-7UNC  pinch 3.6 mm, hole Ø31.8 mm, As = 492.4 mm²
+	// 1-1/4"-7UNC  pinch 3.6 mm, hole Ø34.9 mm, As = 625.2 mm²
+	// 1-3/8"-6UNC  pinch 4.2 mm, hole Ø38.1 mm, As = 745.1 mm²
+	// 1-1/2"-6UNC  pinch 4.2 mm, hole Ø41.3 mm, As = 906.6 mm²
+}
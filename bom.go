@@ -0,0 +1,199 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Mass - type of mass.
+// unit: kg
+type Mass float64
+
+func (m Mass) String() string {
+	return fmt.Sprintf("%.3f kg", float64(m))
+}
+
+// densitySteel - density of steel.
+// unit: kg/m3
+const densitySteel = 7850.0
+
+// Coating - coating of fasteners
+type Coating string
+
+// Typical coatings of fasteners
+const (
+	Black      Coating = "black"
+	Galvanized Coating = "hot-dip galvanized"
+	Zinc       Coating = "zinc plated"
+)
+
+// hexagonArea - return area of hexagon with width across flats
+func hexagonArea(s Dimension) float64 {
+	return math.Sqrt(3.0) / 2.0 * float64(s) * float64(s)
+}
+
+// Mass - return masses of bolt with length L, one nut and one washer
+// of assembly. Mass of bolt is calculated by area of shank AreaA for
+// the unthreaded portion and by area AreaAs for the thread.
+func (a Assembly) Mass(L Dimension) (boltMass, nutMass, washerMass Mass, err error) {
+	head, hn, w, err := a.Components()
+	if err != nil {
+		return
+	}
+	b := a.ThreadLength(L)
+	v := hexagonArea(head.S)*float64(head.K) +
+		float64(a.B.A().Value())*float64(L-b) +
+		float64(a.B.As().Value())*float64(b)
+	boltMass = Mass(v * densitySteel)
+	d := float64(a.B.D())
+	if 0 < a.Nuts {
+		v = (hexagonArea(hn.S) - math.Pi*d*d/4.0) * float64(hn.K)
+		nutMass = Mass(v * densitySteel)
+	}
+	if 0 < a.Washers {
+		v = math.Pi / 4.0 * (float64(w.D2*w.D2) - float64(w.D1*w.D1)) * float64(w.H)
+		washerMass = Mass(v * densitySteel)
+	}
+	return
+}
+
+// Item - item of bill of materials
+type Item struct {
+	A        Assembly
+	L        Dimension // length of bolt
+	Coating  Coating
+	Quantity int // amount of assemblies
+}
+
+// Total - return mass of all assemblies of item
+func (it Item) Total() (Mass, error) {
+	boltMass, nutMass, washerMass, err := it.A.Mass(it.L)
+	if err != nil {
+		return 0.0, err
+	}
+	m := boltMass + Mass(it.A.Nuts)*nutMass + Mass(it.A.Washers)*washerMass
+	return Mass(it.Quantity) * m, nil
+}
+
+func (it Item) String() string {
+	s := fmt.Sprintf("%d x %s x %s %s", it.Quantity, it.A.B, it.L, it.A.Bolt)
+	if 0 < it.A.Nuts {
+		s += fmt.Sprintf(", %d nut %s", it.A.Nuts, it.A.Nut)
+	}
+	if 0 < it.A.Washers {
+		s += fmt.Sprintf(", %d washer %s", it.A.Washers, it.A.Washer)
+	}
+	if it.Coating != "" {
+		s += fmt.Sprintf(", %s", it.Coating)
+	}
+	return s
+}
+
+// Connection - list of bolt assemblies of connection
+type Connection struct {
+	Name  string
+	Items []Item
+}
+
+// Quantity - return amount of bolts of connection
+func (con Connection) Quantity() (bolts int) {
+	for _, it := range con.Items {
+		bolts += it.Quantity
+	}
+	return
+}
+
+// Mass - return mass of all assemblies of connection
+func (con Connection) Mass() (m Mass, err error) {
+	for _, it := range con.Items {
+		total, err := it.Total()
+		if err != nil {
+			return 0.0, err
+		}
+		m += total
+	}
+	return
+}
+
+func (con Connection) String() string {
+	s := fmt.Sprintf("%s: %d bolts", con.Name, con.Quantity())
+	m, err := con.Mass()
+	if err != nil {
+		return s + fmt.Sprintf(", error: %v", err)
+	}
+	return s + fmt.Sprintf(", mass %s", m)
+}
+
+// BOM - bill of materials
+type BOM struct {
+	Connections []Connection // connections of bill of materials
+	Items       []Item       // aggregated items of all connections
+}
+
+// NewBOM - return bill of materials with aggregated items of connections
+func NewBOM(cons ...Connection) (bom BOM) {
+	bom.Connections = cons
+	for _, con := range cons {
+		for _, it := range con.Items {
+			found := false
+			for i := range bom.Items {
+				if bom.Items[i].A == it.A && bom.Items[i].L == it.L && bom.Items[i].Coating == it.Coating {
+					bom.Items[i].Quantity += it.Quantity
+					found = true
+					break
+				}
+			}
+			if !found {
+				bom.Items = append(bom.Items, it)
+			}
+		}
+	}
+	sort.SliceStable(bom.Items, func(i, j int) bool {
+		if bom.Items[i].A.B.D() != bom.Items[j].A.B.D() {
+			return bom.Items[i].A.B.D() < bom.Items[j].A.B.D()
+		}
+		return bom.Items[i].L < bom.Items[j].L
+	})
+	return
+}
+
+// Quantity - return total amount of bolts, nuts and washers
+func (bom BOM) Quantity() (bolts, nuts, washers int) {
+	for _, it := range bom.Items {
+		bolts += it.Quantity
+		nuts += it.Quantity * it.A.Nuts
+		washers += it.Quantity * it.A.Washers
+	}
+	return
+}
+
+// Mass - return total mass of bill of materials
+func (bom BOM) Mass() (Mass, error) {
+	return Connection{Items: bom.Items}.Mass()
+}
+
+func (bom BOM) String() (s string) {
+	s += "Bill of materials:\n"
+	for _, it := range bom.Items {
+		total, err := it.Total()
+		if err != nil {
+			s += fmt.Sprintf("\t%s, error: %v\n", it, err)
+			continue
+		}
+		s += fmt.Sprintf("\t%s, mass %s\n", it, total)
+	}
+	if 0 < len(bom.Connections) {
+		s += "Connections:\n"
+		for _, con := range bom.Connections {
+			s += fmt.Sprintf("\t%s\n", con)
+		}
+	}
+	bolts, nuts, washers := bom.Quantity()
+	s += fmt.Sprintf("Total: %d bolts, %d nuts, %d washers", bolts, nuts, washers)
+	if m, err := bom.Mass(); err == nil {
+		s += fmt.Sprintf(", mass %s", m)
+	}
+	s += "\n"
+	return
+}
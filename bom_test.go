@@ -0,0 +1,113 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleBOM() {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D20, bolt.G8p8),
+		Bolt:    bolt.ISO4014,
+		Nut:     bolt.ISO4032,
+		Nuts:    1,
+		Washer:  bolt.ISO7089,
+		Washers: 2,
+	}
+	cons := []bolt.Connection{
+		{
+			Name: "Fin plate",
+			Items: []bolt.Item{
+				{A: a, L: 60e-3, Coating: bolt.Galvanized, Quantity: 4},
+			},
+		},
+		{
+			Name: "Splice",
+			Items: []bolt.Item{
+				{A: a, L: 60e-3, Coating: bolt.Galvanized, Quantity: 16},
+				{A: a, L: 80e-3, Coating: bolt.Galvanized, Quantity: 8},
+			},
+		},
+	}
+	fmt.Fprintf(os.Stdout, "%s", bolt.NewBOM(cons...))
+
+	// Output:
+	// Bill of materials:
+	// 	20 x HM20Cl8.8 x 60.0 mm ISO 4014, 1 nut ISO 4032, 2 washer ISO 7089, hot-dip galvanized, mass 5.991 kg
+	// 	8 x HM20Cl8.8 x 80.0 mm ISO 4014, 1 nut ISO 4032, 2 washer ISO 7089, hot-dip galvanized, mass 2.791 kg
+	// Connections:
+	// 	Fin plate: 4 bolts, mass 1.198 kg
+	// 	Splice: 24 bolts, mass 7.584 kg
+	// Total: 28 bolts, 28 nuts, 56 washers, mass 8.782 kg
+}
+
+func TestAssemblyMass(t *testing.T) {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D20, bolt.G8p8),
+		Bolt:    bolt.ISO4014,
+		Nut:     bolt.ISO4032,
+		Nuts:    1,
+		Washer:  bolt.ISO7089,
+		Washers: 1,
+	}
+	// catalogue masses: bolt M20x60 - 0.195 kg, nut M20 - 0.062 kg,
+	// washer M20 - 0.017 kg
+	b, n, w, err := a.Mass(60e-3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []struct {
+		actual, expect bolt.Mass
+	}{
+		{b, 0.195},
+		{n, 0.062},
+		{w, 0.017},
+	} {
+		if math.Abs(float64(m.actual-m.expect))/float64(m.expect) > 0.1 {
+			t.Errorf("mass %s is not near to %s", m.actual, m.expect)
+		}
+	}
+}
+
+func TestBOMConnections(t *testing.T) {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D16, bolt.G8p8),
+		Bolt:    bolt.ISO4014,
+		Nut:     bolt.ISO4032,
+		Nuts:    1,
+		Washer:  bolt.ISO7089,
+		Washers: 1,
+	}
+	cons := []bolt.Connection{
+		{Name: "A", Items: []bolt.Item{{A: a, L: 50e-3, Quantity: 2}, {A: a, L: 60e-3, Quantity: 3}}},
+		{Name: "B", Items: []bolt.Item{{A: a, L: 50e-3, Quantity: 6}}},
+	}
+	bom := bolt.NewBOM(cons...)
+	if len(bom.Connections) != len(cons) {
+		t.Fatalf("connections are lost")
+	}
+	var bolts int
+	var mass bolt.Mass
+	for _, con := range bom.Connections {
+		m, err := con.Mass()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bolts += con.Quantity()
+		mass += m
+	}
+	total, err := bom.Mass()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _, _ := bom.Quantity(); b != bolts || b != 11 {
+		t.Errorf("amount of bolts of connections %d is not same total %d", bolts, b)
+	}
+	if math.Abs(float64(total-mass)) > 1e-9 {
+		t.Errorf("mass of connections %s is not same total %s", mass, total)
+	}
+}
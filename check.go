@@ -0,0 +1,56 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// Check - result of one design check of connection
+type Check struct {
+	Name string // name of check with reference to standard
+	Ed   Force  // design value of load
+	Rd   Force  // design value of resistance
+}
+
+// Value - return utilization factor of check
+// Check with zero resistance is not passed, if load is not zero.
+func (c Check) Value() Factor {
+	if c.Ed != 0.0 && c.Rd == 0.0 {
+		return Factor(math.Inf(1))
+	}
+	return Factor(ratio(c.Ed, c.Rd))
+}
+
+func (c Check) String() string {
+	return c.In(SI())
+}
+
+// In - return check formatted in system of units
+func (c Check) In(u Units) string {
+	return u.Sprintf("%s: Ed = %s, Rd = %s, factor %s", c.Name, c.Ed, c.Rd, c.Value())
+}
+
+// Report - list of design checks of connection
+type Report []Check
+
+// Value - return maximal utilization factor of all checks
+func (r Report) Value() Factor {
+	max := 0.0
+	for _, c := range r {
+		max = math.Max(max, float64(c.Value()))
+	}
+	return Factor(max)
+}
+
+func (r Report) String() string {
+	return r.In(SI())
+}
+
+// In - return report formatted in system of units
+func (r Report) In(u Units) (s string) {
+	for _, c := range r {
+		s += fmt.Sprintf("\t%s\n", c.In(u))
+	}
+	s += fmt.Sprintf("Summary factor is %s\n", r.Value())
+	return
+}
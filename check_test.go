@@ -0,0 +1,34 @@
+package bolt_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func TestCheck(t *testing.T) {
+	tcs := []struct {
+		c      bolt.Check
+		expect float64
+	}{
+		{c: bolt.Check{Ed: 0, Rd: 0}, expect: 0.0},
+		{c: bolt.Check{Ed: 0, Rd: 10e3}, expect: 0.0},
+		{c: bolt.Check{Ed: 5e3, Rd: 10e3}, expect: 0.5},
+		{c: bolt.Check{Ed: 5e3, Rd: 0}, expect: math.Inf(1)},
+	}
+	for _, tc := range tcs {
+		if v := float64(tc.c.Value()); v != tc.expect {
+			t.Errorf("factor of %s is %v, but expect %v", tc.c, v, tc.expect)
+		}
+	}
+}
+
+func TestCheckZeroResistance(t *testing.T) {
+	// fin plate without ultimate strength of plate and web
+	fp := finPlate()
+	fp.Fu, fp.FuWeb = 0.0, 0.0
+	if f, s := fp.Value(100e3, bolt.FullView); !math.IsInf(float64(f), 1) {
+		t.Errorf("zero resistance is passed:\n%s", s)
+	}
+}
@@ -18,16 +18,13 @@ var Description map[string]string = map[string]string{
 	"fub": "the ultimate tensile strength. Unit - Pa",
 	"fyb": "the yield strength. Unit - Pa",
 
-	"FactorγM2": "the partial safety factor",
-
 	"FtEd": "the design tensile force per bolt for the ultimate limit state. Unit - Pa",
 	"FtRd": "the design tension resistance per bolt. Unit - Pa",
 
 	"FvEd": "the design shear force per bolt for the ultimate limit state. Unit - Pa",
 	"FvRd": "the shear design resistance per bolt. Unit - Pa",
 
-	"ανThreadShear":   "factor if shear by thread of bolt",
-	"ανUnthreadShear": "factor if shear not by thread of bolt",
+	"ανThreadShear": "factor if shear by thread of bolt",
 
 	"max": "local variable of maximal value",
 	"f1":  "local value of ratio",
@@ -39,14 +36,476 @@ var Description map[string]string = map[string]string{
 	"ThreadShear":   "location of shear area on thread",
 	"UnthreadShear": "location of shear area not on thread",
 
+	"NEd": "the design axial force, positive in tension. Unit - N",
+	"MEd": "the design bending moment. Unit - N*m",
+
+	"factorβj":    "the foundation joint material coefficient",
+	"c":           "additional bearing width of T-stub in compression. Unit - meter",
+	"beff":        "effective width of T-stub flange. Unit - meter",
+	"leff":        "effective length of T-stub flange. Unit - meter",
+	"mx":          "distance from bolt axis to weld of flange. Unit - meter",
+	"ex":          "distance from bolt axis to free edge of T-stub. Unit - meter",
+	"e":           "distance from bolt axis to edge along the bolt row. Unit - meter",
+	"w":           "distance between bolts in the row. Unit - meter",
+	"circular":    "effective length for circular patterns. Unit - meter",
+	"nonCircular": "effective length for non-circular patterns. Unit - meter",
+	"mpl":         "plastic moment of T-stub flange. Unit - N*m",
+	"ft12":        "tension resistance of T-stub for modes 1-2. Unit - N",
+	"ft3":         "tension resistance of T-stub for mode 3. Unit - N",
+	"ft":          "tension resistance of T-stub. Unit - N",
+	"fc":          "compression resistance of T-stub. Unit - N",
+	"zt":          "lever arm of tension T-stub. Unit - meter",
+	"zc":          "lever arm of compression T-stub. Unit - meter",
+	"points":      "points of interaction diagram",
+	"λ":           "load multiplier up to border of interaction diagram",
+	"det":         "determinant of linear system",
+
+	// anchor
+	"CrackedConcrete":   "condition of concrete",
+	"UncrackedConcrete": "condition of concrete",
+	"VEd":               "the design shear force. Unit - N",
+	"acN":               "actual projected area of concrete cone. Unit - sq.mm",
+	"a0cN":              "reference projected area of concrete cone. Unit - sq.mm",
+	"acNb":              "actual projected area of blow-out cone. Unit - sq.mm",
+	"a0cNb":             "reference projected area of blow-out cone. Unit - sq.mm",
+	"acV":               "actual projected area of concrete edge cone. Unit - sq.mm",
+	"a0cV":              "reference projected area of concrete edge cone. Unit - sq.mm",
+	"c1":                "edge distance in direction 1. Unit - mm",
+	"c2":                "edge distance in direction 2. Unit - mm",
+	"cn":                "edge distance opposite to edge c. Unit - mm",
+	"cs":                "list of edge distances",
+	"ccr":               "characteristic edge distance. Unit - mm",
+	"scr":               "characteristic spacing. Unit - mm",
+	"dh":                "diameter of anchor head. Unit - mm",
+	"dnom":              "nominal diameter of anchor. Unit - mm",
+	"f":                 "distance between head of anchor and lower face of concrete. Unit - mm",
+	"h":                 "thickness of concrete member. Unit - mm",
+	"hef":               "effective embedment depth. Unit - mm",
+	"k1":                "factor for concrete cone",
+	"k2":                "factor for pull-out",
+	"k5":                "factor for blow-out",
+	"k8":                "factor for pry-out",
+	"k9":                "factor for concrete edge",
+	"lf":                "effective length of anchor for shear. Unit - mm",
+	"lim":               "limit of edge distance. Unit - mm",
+	"load":              "design load",
+	"resistance":        "design resistance",
+	"min":               "local variable of minimal value",
+	"modes":             "list of failure modes",
+	"n0":                "characteristic resistance of single anchor. Unit - N",
+	"nRkCb":             "characteristic resistance of anchor row to blow-out. Unit - N",
+	"nRow":              "amount of anchors in row near the edge",
+	"v0":                "characteristic resistance of single anchor to concrete edge failure. Unit - N",
+	"α":                 "factor of concrete edge failure",
+	"β":                 "factor of concrete edge failure",
+	"βNc":               "maximal ratio of tension for concrete failure modes",
+	"βVc":               "maximal ratio of shear for concrete failure modes",
+	"βs":                "factor of combined loads for steel failure",
+	"βc":                "factor of combined loads for concrete failure",
+	"ψsN":               "factor of disturbance of stresses in concrete by edge",
+	"ψreN":              "factor of shell spalling",
+	"ψecN":              "factor of eccentricity of tension load",
+	"ψhSp":              "factor of member thickness for splitting",
+	"ψsNb":              "factor of disturbance of stresses in concrete by edge for blow-out",
+	"ψgNb":              "factor of group for blow-out",
+	"ψsV":               "factor of disturbance of stresses in concrete by edge for shear",
+	"ψhV":               "factor of member thickness for shear",
+	"ψecV":              "factor of eccentricity of shear load",
+
+	// bearing, block tearing and fin plate
+	"do":   "diameter of bolt hole. Unit - meter",
+	"αd":   "factor of end and inner bolts for bearing",
+	"kt":   "factor of eccentric loading for block tearing",
+	"V":    "shear force on bolt group. Unit - N",
+	"M":    "moment on bolt group. Unit - N*m",
+	"n1":   "amount of bolt rows",
+	"n2":   "amount of bolt lines",
+	"p1":   "spacing of bolt rows. Unit - meter",
+	"p2":   "spacing of bolt lines. Unit - meter",
+	"ip":   "polar moment of bolt group. Unit - sq.meter",
+	"xmax": "maximal horizontal distance from bolt to centroid. Unit - meter",
+	"ymax": "maximal vertical distance from bolt to centroid. Unit - meter",
+	"fv":   "vertical component of bolt force. Unit - N",
+	"fh":   "horizontal component of bolt force. Unit - N",
+	"bv":   "bearing resistance in vertical direction",
+	"bh":   "bearing resistance in horizontal direction",
+	"fEd":  "maximal resultant bolt force. Unit - N",
+	"ed":   "design value of load. Unit - N",
+	"rd":   "design value of resistance. Unit - N",
+	"hp":   "depth of plate. Unit - meter",
+	"tp":   "thickness of plate. Unit - meter",
+	"fwd":  "design strength of transverse fillet weld. Unit - Pa",
+	"r":    "report of design checks",
+
+	// web cleat
+	"anv":  "net area of cleat subjected to shear. Unit - sq.meter",
+	"fvRd": "the shear design resistance per bolt for single shear. Unit - N",
+	"hc":   "depth of cleat. Unit - meter",
+
+	// splice
+	"N": "horizontal force on bolt group. Unit - N",
+
+	// splice
+	"Lj":     "distance between the centres of the end bolts in long joint. Unit - meter",
+	"βLf":    "reduction factor for long joints",
+	"βp":     "reduction factor for packings",
+	"Ff":     "force in flange. Unit - N",
+	"Nw":     "axial force in web. Unit - N",
+	"Mw":     "moment in web. Unit - N*m",
+	"af":     "area of flange. Unit - sq.meter",
+	"aw":     "area of web. Unit - sq.meter",
+	"iw":     "second moment of area of web. Unit - meter^4",
+	"ap":     "gross area of flange cover plates. Unit - sq.meter",
+	"apNet":  "net area of flange cover plates. Unit - sq.meter",
+	"bf":     "width of flange. Unit - meter",
+	"tf":     "thickness of flange. Unit - meter",
+	"tw":     "thickness of web. Unit - meter",
+	"hw":     "depth of web. Unit - meter",
+	"planes": "amount of shear planes",
+
+	// national annex
+	"na":              "national annex profile",
+	"name":            "name of national annex profile",
+	"names":           "list of names of national annex profiles",
+	"nationalAnnexes": "table of national annex profiles",
+	"recommended":     "recommended values of Eurocodes",
+	"ok":              "true if found",
+	"γM0":             "partial factor for resistance of cross-sections",
+	"γM1":             "partial factor for resistance of members to instability",
+	"γM2":             "partial factor for resistance to fracture, bolts and plates in bearing",
+	"γM3":             "partial factor for slip resistance at ultimate limit state",
+	"γM7":             "partial factor for preload of high strength bolts",
+
+	// AISC 360
+	"A325":             "grade of bolt",
+	"A490":             "grade of bolt",
+	"LRFD":             "load and resistance factor design",
+	"ASD":              "allowable strength design",
+	"StandardHole":     "type of bolt hole",
+	"OversizedHole":    "type of bolt hole",
+	"ShortSlottedHole": "type of bolt hole",
+	"LongSlottedHole":  "type of bolt hole",
+	"FactorDu":         "ratio of the mean installed bolt pretension to the specified minimum bolt pretension",
+	"aiscFnt":          "nominal tensile stress. Unit - Pa",
+	"aiscFnvN":         "nominal shear stress, threads not excluded. Unit - Pa",
+	"aiscFnvX":         "nominal shear stress, threads excluded. Unit - Pa",
+	"aiscFu":           "minimum tensile strength of bolt. Unit - Pa",
+	"Fu":               "the ultimate tensile strength of connected material. Unit - Pa",
+	"Vr":               "required shear force per bolt. Unit - N",
+	"Tr":               "required tension force per bolt. Unit - N",
+	"fnt":              "nominal tensile stress. Unit - Pa",
+	"frv":              "required shear stress. Unit - Pa",
+	"k":                "factor of design method",
+	"lc":               "clear distance to edge of hole or material. Unit - meter",
+	"bearing":          "nominal bearing strength. Unit - N",
+	"tearout":          "nominal tearout strength. Unit - N",
+	"hf":               "factor for fillers",
+	"rn":               "nominal strength. Unit - N",
+	"rs":               "available slip resistance. Unit - N",
+	"f3":               "local value of ratio",
+	"φ":                "resistance factor of LRFD",
+	"Ω":                "safety factor of ASD",
+
+	// SP 16.13330
+	"CodeEN1993":     "design code EN1993-1-8",
+	"CodeSP16":       "design code SP 16.13330.2017",
+	"code":           "design code",
+	"bt":             "type of bolt",
+	"pos":            "position of shear plane",
+	"elasticModulus": "modulus of elasticity of steel. Unit - Pa",
+	"run":            "ultimate strength of connected steel. Unit - Pa",
+	"sp16Rbs":        "design strength of bolts in shear. Unit - Pa",
+	"sp16Rbt":        "design strength of bolts in tension. Unit - Pa",
+	"sp16Rbun":       "ultimate strength of high-strength bolts. Unit - Pa",
+	"γb":             "factor of bolt connection",
+	"γc":             "service condition factor",
+
+	// imperial bolts
+	"inch":     "length of one inch. Unit - meter",
+	"imperial": "table of imperial bolt diameter names and amount of UNC threads per inch",
+	"unc":      "name and amount of UNC threads per inch",
+
+	// units
+	"UnitN":     "unit of force",
+	"UnitKN":    "unit of force",
+	"UnitMN":    "unit of force",
+	"UnitLbf":   "unit of force",
+	"UnitKip":   "unit of force",
+	"UnitPa":    "unit of stress",
+	"UnitMPa":   "unit of stress",
+	"UnitGPa":   "unit of stress",
+	"UnitPsi":   "unit of stress",
+	"UnitKsi":   "unit of stress",
+	"UnitM":     "unit of length",
+	"UnitMm":    "unit of length",
+	"UnitIn":    "unit of length",
+	"UnitFt":    "unit of length",
+	"UnitM2":    "unit of area",
+	"UnitCm2":   "unit of area",
+	"UnitMm2":   "unit of area",
+	"UnitIn2":   "unit of area",
+	"UnitNm":    "unit of moment",
+	"UnitKNm":   "unit of moment",
+	"UnitKipIn": "unit of moment",
+	"UnitKipFt": "unit of moment",
+	"alias":     "name of found unit at end of string",
+	"args":      "arguments formatted in system of units",
+	"format":    "format specifier",
+	"number":    "string of number without unit",
+	"precision": "amount of digits after decimal point",
+	"str":       "string of value with unit",
+	"unit":      "found unit",
+	"units":     "list of allowable units",
+	"value":     "value in SI units",
+	"err":       "typical error",
+
+	// fatigue
+	"FatigueTension": "bolt in tension for fatigue",
+	"FatigueShear":   "bolt in shear for fatigue",
+	"area":           "area of bolt for stress range",
+	"dd":             "damage in according to Palmgren-Miner rule",
+	"ranges":         "history of stress ranges",
+	"sp":             "load spectrum of stress ranges",
+	"Δ":              "stress range",
+	"ΔF":             "force range",
+	"Δc":             "reference value of fatigue strength",
+	"Δd":             "constant amplitude fatigue limit",
+
+	// rainflow
+	"add":     "add cycles to histogram",
+	"cycles":  "amount of cycles",
+	"found":   "range is found in histogram",
+	"hist":    "histogram of spectrum",
+	"history": "time history of forces",
+	"stack":   "stack of reversals",
+	"width":   "width of histogram bin",
+
+	// fire
+	"FbRd":   "design bearing resistance at temperature",
+	"br":     "bearing resistance",
+	"factor": "reduction factor of resistance",
+	"kbθ":    "table of strength reduction factors for bolts",
+	"sr":     "shear resistance",
+	"tc":     "temperature-time curve",
+	"tr":     "tension resistance",
+	"θ":      "temperature",
+
+	// connection temperature
+	"θ0":   "temperature of bottom flange of beam",
+	"rows": "rows of bolts",
+	"D":    "depth of beam",
+
+	// tightening
+	"K0":             "k-class without requirement on k-value",
+	"K1":             "k-class with range of individual k-values",
+	"K2":             "k-class with mean k-value and coefficient of variation",
+	"TorqueMethod":   "torque method of tightening",
+	"CombinedMethod": "combined method of tightening",
+	"HRCMethod":      "HRC tightening method",
+	"DTIMethod":      "direct tension indicator method",
+	"allowable":      "allowable k-classes for tightening methods",
+	"mr":             "reference torque",
+
+	// assembly
+	"ISO4014":   "hexagon head bolts, partly threaded",
+	"ISO4017":   "hexagon head screws, fully threaded",
+	"ISO4032":   "hexagon nuts",
+	"ISO7089":   "plain washers, normal series",
+	"ISO7091":   "plain washers, product grade C",
+	"EN14399p3": "system HR, bolts and nuts",
+	"EN14399p4": "system HV, bolts and nuts",
+	"EN14399p6": "plain chamfered washers for systems HR and HV",
+	"d1":        "inner diameter of washer",
+	"d2":        "outer diameter of washer",
+	"head":      "dimensions of bolt head",
+	"heads":     "table of bolt heads",
+	"isoHead":   "table of bolt heads in according to ISO 4014 and ISO 4017",
+	"nut":       "dimensions of nut",
+	"nuts":      "table of nuts",
+	"std":       "standard of fastener component",
+	"washers":   "table of washers",
+
+	// bolt length
+	"L":               "length of bolt",
+	"bl":              "result of bolt length selection",
+	"threads":         "amount of clear threads",
+	"grip":            "grip length",
+	"pitch":           "pitch of thread",
+	"plies":           "thicknesses of clamped plies",
+	"position":        "position of shear plane",
+	"preloaded":       "bolt is preloaded",
+	"standardLengths": "preferred nominal lengths of bolts",
+	"threadHV":        "thread length of HV bolts",
+	"z":               "distance from head of bolt",
+
+	// bolt length
+	"holds": "assumption of position of shear planes holds",
+
+	// bill of materials
+	"Black":        "black fasteners without coating",
+	"Galvanized":   "hot-dip galvanized fasteners",
+	"Zinc":         "zinc plated fasteners",
+	"boltMass":     "mass of bolt",
+	"nutMass":      "mass of nut",
+	"washerMass":   "mass of washer",
+	"bolts":        "amount of bolts",
+	"bom":          "bill of materials",
+	"cons":         "connections",
+	"densitySteel": "density of steel",
+	"hn":           "dimensions of nut",
+	"total":        "mass of item",
+
+	// selection
+	"ByCost":   "ranking of bolts by cost",
+	"ByWeight": "ranking of bolts by weight",
+	"classes":  "allowable classes of bolts",
+	"hd":       "diameter of hole",
+	"rank":     "criteria of ranking",
+
+	// layout
+	"best":     "layout with the lowest bolt count",
+	"emax":     "maximal edge distance",
+	"emin":     "minimal edge distance",
+	"l":        "layout of bolts",
+	"length":   "length of plate",
+	"maxBolts": "maximal amount of bolts in layout",
+	"ok1":      "layout in direction 1 is valid",
+	"ok2":      "layout in direction 2 is valid",
+	"pmax":     "maximal spacing",
+	"pmin":     "minimal spacing",
+
+	// pattern
+	"AlongX":           "load transfer along axis X",
+	"AlongY":           "load transfer along axis Y",
+	"ExposedToWeather": "steel exposed to the weather",
+	"NotExposed":       "steel not exposed to the weather",
+	"at":               "coordinates of holes",
+	"columns":          "lines of holes perpendicular to load transfer",
+	"compare":          "result of comparing with limit",
+	"du":               "distance along load transfer",
+	"dv":               "distance perpendicular to load transfer",
+	"e1":               "end distance",
+	"e2":               "edge distance",
+	"eps":              "precision of coordinates",
+	"key":              "key of line",
+	"limit":            "limit of distance",
+	"lines":            "lines of holes parallel to load transfer",
+	"outer":            "keys of outer lines",
+	"pt":               "point",
+	"ua":               "coordinate of first hole along load transfer",
+	"ub":               "coordinate of second hole along load transfer",
+	"ui":               "coordinate along load transfer",
+	"uj":               "coordinate along load transfer",
+	"umax":             "maximal coordinate of plate along load transfer",
+	"umin":             "minimal coordinate of plate along load transfer",
+	"va":               "coordinate of first hole perpendicular to load transfer",
+	"vb":               "coordinate of second hole perpendicular to load transfer",
+	"vi":               "coordinate perpendicular to load transfer",
+	"vj":               "coordinate perpendicular to load transfer",
+	"vmax":             "maximal coordinate of plate perpendicular to load transfer",
+	"vmin":             "minimal coordinate of plate perpendicular to load transfer",
+	"vs":               "violations",
+
+	// pattern
+	"near": "the nearest holes to edges of plate",
+	"u0":   "minimal coordinate of holes along load transfer",
+	"u1":   "maximal coordinate of holes along load transfer",
+	"v1":   "maximal coordinate of holes perpendicular to load transfer",
+
+	// distance
+	"WeatheringSteel": "weathering steel in according to EN10025-5",
+	"exposure":        "exposure condition of connection",
+	"show":            "return view of dimension",
+
+	// net area
+	"A":         "gross area of cross-section",
+	"direction": "direction of load transfer",
+	"holes":     "centers of holes",
+	"last":      "last hole of critical path",
+	"path":      "critical path of holes",
+	"prev":      "previous hole of critical path",
+	"pv":        "spacing perpendicular to load transfer",
+	"reduction": "reduction of area for paths",
+	"sorted":    "holes sorted perpendicular to load transfer",
+
+	// tension member
+	"anet": "net area of cross-section",
+	"nnet": "design plastic resistance of the net cross-section",
+	"nt":   "design tension resistance of member",
+	"nu":   "design ultimate resistance of the net cross-section",
+
+	// steel grades
+	"fu":        "ultimate tensile strength",
+	"fu40":      "ultimate tensile strength for thickness up to 40 mm",
+	"fu80":      "ultimate tensile strength for thickness from 40 mm up to 80 mm",
+	"fy":        "yield strength",
+	"fy40":      "yield strength for thickness up to 40 mm",
+	"fy80":      "yield strength for thickness from 40 mm up to 80 mm",
+	"grade":     "steel grade",
+	"grades":    "list of steel grades",
+	"sg":        "steel grade data",
+	"standard":  "standard of steel grade",
+	"steels":    "table of steel grades",
+	"strengths": "thickness-dependent strengths of steel",
+
+	// anchor failure modes
+	"hmin":             "minimal thickness of concrete member",
+	"modeSteelTension": "steel failure of anchor on tension",
+	"modeConcreteCone": "concrete cone failure",
+	"modePullOut":      "pull-out failure",
+	"modeSplitting":    "splitting failure",
+	"modeBlowOut":      "blow-out failure",
+	"modeSteelShear":   "steel failure of anchor on shear",
+	"modePryOut":       "concrete pry-out failure",
+	"modeConcreteEdge": "concrete edge failure",
+
+	// web cleat
+	"fcv": "vertical force of column side bolt",
+	"fch": "horizontal force of column side bolt",
+
+	// units of report
+	"si": "SI units",
+
+	// bolt selection
+	"price": "price of bolt class per kg",
+
+	// strength of beam web
+	"fyWeb": "yield strength of beam web",
+	"fuWeb": "ultimate tensile strength of beam web",
+
+	// strength of splice elements
+	"fuFlange":   "ultimate tensile strength of member flange",
+	"fyCover":    "yield strength of flange cover plates",
+	"fuCover":    "ultimate tensile strength of flange cover plates",
+	"fyWebCover": "yield strength of web cover plates",
+	"fuWebCover": "ultimate tensile strength of web cover plates",
+
+	// slip resistance
+	"fp": "preload force reduced by design tensile force",
+
+	// errors of strength
+	"errCover":    "error of strength of flange cover plates",
+	"errFlange":   "error of strength of flange",
+	"errWeb":      "error of strength of web",
+	"errWebCover": "error of strength of web cover plates",
+
 	// ignore
 	"G4p6": "", "G4p8": "", "G5p6": "",
-	"G5p8": "", "G6p8": "", "G8p8": "", "G10p9": "",
+	"G5p8": "", "G6p8": "", "G8p8": "", "G10p9": "", "G6p6": "",
 
 	"D12": "", "D16": "", "D20": "", "D24": "",
 	"D30": "", "D36": "", "D42": "", "D48": "",
 
+	"D1o2in": "", "D5o8in": "", "D3o4in": "", "D7o8in": "", "D1in": "",
+	"D1p1o8in": "", "D1p1o4in": "", "D1p3o8in": "", "D1p1o2in": "",
+
 	"d": "", "p": "", "pd": "", "s": "",
+	"a": "", "dn": "", "dm": "", "m": "", "n": "", "t": "",
 
 	"class": "", "fubData": "", "fybData": "", "αν": "",
+	"i": "", "j": "", "x": "", "y": "", "u": "", "v": "",
+	"S235": "", "S275": "", "S355": "", "S450": "",
+	"S275N": "", "S355N": "", "S420N": "", "S460N": "",
+	"S275M": "", "S355M": "", "S420M": "", "S460M": "",
+	"S235W": "", "S355W": "", "S460Q": "",
 }
@@ -5,17 +5,59 @@ import (
 	"math"
 )
 
+// Exposure - exposure condition of connection in according to
+// table 3.3 EN1993-1-8
+type Exposure int
+
+// Constants of exposure conditions
+const (
+	ExposedToWeather Exposure = iota // steel exposed to the weather or other corrosive influences
+	NotExposed                       // steel not exposed to the weather or other corrosive influences
+	WeatheringSteel                  // steel in according to EN10025-5
+)
+
+func (e Exposure) String() string {
+	switch e {
+	case NotExposed:
+		return "steel not exposed to the weather"
+	case WeatheringSteel:
+		return "weathering steel in according to EN10025-5"
+	}
+	return "steel exposed to the weather"
+}
+
 // Distance - distances in according to table 3.3 EN1993-1-8.
+// Maximal distance without limit is infinity.
 // Unit - meter
 type Distance struct {
-	b   Bolt
-	thk Dimension
+	b        Bolt
+	thk      Dimension
+	exposure Exposure
 }
 
 // GetDistances - create a struct with all dimensions
 // Unit - meter
-func GetDistances(b Bolt, thk Dimension) Distance {
-	return Distance{b: b, thk: thk}
+func GetDistances(b Bolt, thk Dimension, exposure Exposure) Distance {
+	return Distance{b: b, thk: thk, exposure: exposure}
+}
+
+// emax - dimension of maximal end and edge distances
+func (d Distance) emax() Dimension {
+	switch d.exposure {
+	case NotExposed:
+		return Dimension(math.Inf(1))
+	case WeatheringSteel:
+		return Dimension(math.Max(8.0*float64(d.thk), 125.0e-3))
+	}
+	return Dimension(4.0*float64(d.thk) + 40.0e-3)
+}
+
+// pmax - dimension of maximal spacing
+func (d Distance) pmax() Dimension {
+	if d.exposure == WeatheringSteel {
+		return Dimension(math.Min(14.0*float64(d.thk), 175.0e-3))
+	}
+	return Dimension(math.Min(14.0*float64(d.thk), 200.0e-3))
 }
 
 // E1min - dimension e1min in according to table 3.3 EN1993-1-8
@@ -25,7 +67,7 @@ func (d Distance) E1min() Dimension {
 
 // E1max - dimension e1max in according to table 3.3 EN1993-1-8
 func (d Distance) E1max() Dimension {
-	return Dimension(4.0*float64(d.thk) + 40.0e-3)
+	return d.emax()
 }
 
 // E2min - dimension e2min in according to table 3.3 EN1993-1-8
@@ -35,7 +77,7 @@ func (d Distance) E2min() Dimension {
 
 // E2max - dimension e2max in according to table 3.3 EN1993-1-8
 func (d Distance) E2max() Dimension {
-	return Dimension(4.0*float64(d.thk) + 40.0e-3)
+	return d.emax()
 }
 
 // E3min - dimension e3min in according to table 3.3 EN1993-1-8
@@ -55,7 +97,7 @@ func (d Distance) P1min() Dimension {
 
 // P1max - dimension p1max in according to table 3.3 EN1993-1-8
 func (d Distance) P1max() Dimension {
-	return Dimension(math.Min(14.0*float64(d.thk), 200.0e-3))
+	return d.pmax()
 }
 
 // P10max - dimension p10max in according to table 3.3 EN1993-1-8
@@ -75,18 +117,24 @@ func (d Distance) P2min() Dimension {
 
 // P2max - dimension p2max in according to table 3.3 EN1993-1-8
 func (d Distance) P2max() Dimension {
-	return Dimension(math.Min(14.0*float64(d.thk), 200.0e-3))
+	return d.pmax()
 }
 
 // ShowAllDimensions - print all dimensions in according to table 3.3 EN1993-1-8
 // Unit - meter
-func ShowAllDimensions(b Bolt, thk Dimension) (s string) {
-	d := GetDistances(b, thk)
+func ShowAllDimensions(b Bolt, thk Dimension, exposure Exposure) (s string) {
+	d := GetDistances(b, thk, exposure)
+	show := func(dim Dimension) string {
+		if math.IsInf(float64(dim), 1) {
+			return "not limited"
+		}
+		return dim.String()
+	}
 	s += fmt.Sprintf("E1min  = %s\n", d.E1min())
-	s += fmt.Sprintf("E1max  = %s\n", d.E1max())
+	s += fmt.Sprintf("E1max  = %s\n", show(d.E1max()))
 
 	s += fmt.Sprintf("E2min  = %s\n", d.E2min())
-	s += fmt.Sprintf("E2max  = %s\n", d.E2max())
+	s += fmt.Sprintf("E2max  = %s\n", show(d.E2max()))
 
 	s += fmt.Sprintf("E3min  = %s\n", d.E3min())
 
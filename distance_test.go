@@ -8,7 +8,7 @@ import (
 
 func ExampleDistance() {
 	b := bolt.New(bolt.D24, bolt.G5p8)
-	fmt.Println(bolt.ShowAllDimensions(b, bolt.Dimension(40e-3)))
+	fmt.Println(bolt.ShowAllDimensions(b, bolt.Dimension(40e-3), bolt.ExposedToWeather))
 
 	// Output:
 	// E1min  = 31.2 mm
@@ -25,3 +25,58 @@ func ExampleDistance() {
 	// P2min  = 62.4 mm
 	// P2max  = 200.0 mm
 }
+
+func ExampleGetDistances() {
+	b := bolt.New(bolt.D20, bolt.G8p8)
+	for _, exposure := range []bolt.Exposure{
+		bolt.ExposedToWeather,
+		bolt.NotExposed,
+		bolt.WeatheringSteel,
+	} {
+		fmt.Printf("%s:\n%s", exposure, bolt.ShowAllDimensions(b, 10e-3, exposure))
+	}
+
+	// Output:
+	// steel exposed to the weather:
+	// E1min  = 26.4 mm
+	// E1max  = 80.0 mm
+	// E2min  = 26.4 mm
+	// E2max  = 80.0 mm
+	// E3min  = 33.0 mm
+	// E4min  = 33.0 mm
+	// P1min  = 48.4 mm
+	// P1max  = 140.0 mm
+	// P1max  = 140.0 mm
+	// P10max = 140.0 mm
+	// P1imax = 280.0 mm
+	// P2min  = 52.8 mm
+	// P2max  = 140.0 mm
+	// steel not exposed to the weather:
+	// E1min  = 26.4 mm
+	// E1max  = not limited
+	// E2min  = 26.4 mm
+	// E2max  = not limited
+	// E3min  = 33.0 mm
+	// E4min  = 33.0 mm
+	// P1min  = 48.4 mm
+	// P1max  = 140.0 mm
+	// P1max  = 140.0 mm
+	// P10max = 140.0 mm
+	// P1imax = 280.0 mm
+	// P2min  = 52.8 mm
+	// P2max  = 140.0 mm
+	// weathering steel in according to EN10025-5:
+	// E1min  = 26.4 mm
+	// E1max  = 125.0 mm
+	// E2min  = 26.4 mm
+	// E2max  = 125.0 mm
+	// E3min  = 33.0 mm
+	// E4min  = 33.0 mm
+	// P1min  = 48.4 mm
+	// P1max  = 140.0 mm
+	// P1max  = 140.0 mm
+	// P10max = 140.0 mm
+	// P1imax = 280.0 mm
+	// P2min  = 52.8 mm
+	// P2max  = 140.0 mm
+}
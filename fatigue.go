@@ -0,0 +1,264 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Block - block of load spectrum with constant stress range
+type Block struct {
+	Range  Stress  // stress range
+	Cycles float64 // amount of cycles
+}
+
+func (bl Block) String() string {
+	return bl.In(SI())
+}
+
+// In - return block formatted in system of units
+func (bl Block) In(u Units) string {
+	return u.Sprintf("%s cycles of %s", strconv.FormatFloat(bl.Cycles, 'f', -1, 64), bl.Range)
+}
+
+// Spectrum - load spectrum of stress ranges
+type Spectrum []Block
+
+// NewSpectrum - return spectrum for history of stress ranges, each
+// stress range is one cycle
+func NewSpectrum(ranges []Stress) (sp Spectrum) {
+	for _, r := range ranges {
+		sp = append(sp, Block{Range: r, Cycles: 1.0})
+	}
+	return
+}
+
+// FatigueMode - mode of bolt loading for fatigue
+type FatigueMode bool
+
+// Constants
+const (
+	FatigueTension FatigueMode = false
+	FatigueShear               = true
+)
+
+func (fm FatigueMode) String() string {
+	if fm { // == FatigueShear
+		return "bolt in shear, detail category 100, m = 5"
+	}
+	return "bolt in tension, detail category 50, m = 3"
+}
+
+// Fatigue - fatigue of bolt in according to EN1993-1-9.
+// Default values of partial factors are γFf = 1.0 and γMf = 1.35
+// (safe life assessment method with high consequence of failure).
+// Bolt in shear is valid only for shear plane through unthreaded
+// portion of bolt.
+type Fatigue struct {
+	B         Bolt
+	Mode      FatigueMode
+	Position  PositionShear // position of shear plane for bolt in shear
+	FactorγFf Factor        // partial factor for equivalent constant amplitude stress ranges
+	FactorγMf Factor        // partial factor for fatigue strength
+	Units     Units         // units of report, SI units if empty
+}
+
+// γFf - partial factor for equivalent constant amplitude stress ranges
+func (f Fatigue) γFf() float64 {
+	if f.FactorγFf == 0.0 {
+		return 1.0
+	}
+	return float64(f.FactorγFf)
+}
+
+// γMf - partial factor for fatigue strength
+func (f Fatigue) γMf() float64 {
+	if f.FactorγMf == 0.0 {
+		return 1.35
+	}
+	return float64(f.FactorγMf)
+}
+
+// Validate - return error for detail not covered by table 8.5 EN1993-1-9
+func (f Fatigue) Validate() error {
+	if f.Mode == FatigueShear && f.Position == ThreadShear {
+		return fmt.Errorf("detail category 100 is not applicable for bolt in shear: %s", f.Position)
+	}
+	return nil
+}
+
+// StressRange - return stress range in bolt for force range.
+// For bolt in tension stress range is calculated for tension stress area
+// of bolt, for bolt in shear - for area of shear plane.
+func (f Fatigue) StressRange(ΔF Force) Stress {
+	area := f.B.As().Value()
+	if f.Mode == FatigueShear && f.Position == UnthreadShear {
+		area = f.B.A().Value()
+	}
+	return Stress(float64(ΔF) / float64(area))
+}
+
+// Category - reference value of fatigue strength at 2 million cycles
+// in according to tables 8.1 and 8.5 EN1993-1-9
+func (f Fatigue) Category() Stress {
+	if f.Mode == FatigueShear {
+		return 100.e6
+	}
+	return 50.e6
+}
+
+// Ks - reduction factor for fatigue stress to account for size effects
+// in according to table 8.1 EN1993-1-9
+func (f Fatigue) Ks() Factor {
+	d := float64(f.B.D())
+	if f.Mode == FatigueTension && 30.e-3 < d {
+		return Factor(math.Pow(30.e-3/d, 0.25))
+	}
+	return 1.0
+}
+
+// Endurance - return amount of cycles up to failure for stress range
+// in according to 7.1 EN1993-1-9. Return infinity for stress range
+// below the cut off limit.
+func (f Fatigue) Endurance(Δ Stress) float64 {
+	Δ = Stress(float64(Δ) * f.γFf())
+	Δc := float64(f.Ks()) * float64(f.Category()) / f.γMf()
+	if f.Mode == FatigueShear {
+		if float64(Δ) < 0.457*Δc {
+			return math.Inf(1)
+		}
+		return 2.e6 * math.Pow(Δc/float64(Δ), 5.0)
+	}
+	Δd := 0.737 * Δc
+	switch {
+	case Δd <= float64(Δ):
+		return 2.e6 * math.Pow(Δc/float64(Δ), 3.0)
+	case 0.405*Δc <= float64(Δ):
+		return 5.e6 * math.Pow(Δd/float64(Δ), 5.0)
+	}
+	return math.Inf(1)
+}
+
+// Damage - return damage of spectrum in according to Palmgren-Miner rule,
+// see A.5 EN1993-1-9. Return infinity for not valid detail.
+func (f Fatigue) Damage(sp Spectrum) Factor {
+	if f.Validate() != nil {
+		return Factor(math.Inf(1))
+	}
+	var dd float64
+	for _, bl := range sp {
+		dd += bl.Cycles / f.Endurance(bl.Range)
+	}
+	return Factor(dd)
+}
+
+// Value - return result of fatigue calculation for spectrum.
+// Not valid detail is failed with infinity factor.
+func (f Fatigue) Value(sp Spectrum, view ViewResult) (_ Factor, s string) {
+	u := f.Units.get()
+	if err := f.Validate(); err != nil {
+		return f.Damage(sp), u.Sprintf("Calculation of fatigue for %s is not valid: %v\n", f.B, err)
+	}
+	dd := f.Damage(sp)
+	if view == FullView {
+		s += u.Sprintf("Calculation of fatigue for %s, %s:\n", f.B, f.Mode)
+		s += u.Sprintf("\tγFf = %s\n", Factor(f.γFf()))
+		s += u.Sprintf("\tγMf = %s\n", Factor(f.γMf()))
+		s += u.Sprintf("\tks  = %s\n", f.Ks())
+		for _, bl := range sp {
+			s += u.Sprintf("\t%s, endurance is %.0f cycles\n", bl, f.Endurance(bl.Range))
+		}
+		s += "\tIn according to A.5 EN1993-1-9:\n"
+		s += u.Sprintf("Damage is %s\n", dd)
+	}
+	return dd, s
+}
+
+// reversals - return peaks and valleys of history
+func reversals(history []Force) (rs []Force) {
+	for _, v := range history {
+		n := len(rs)
+		switch {
+		case n > 0 && v == rs[n-1]:
+			// ignore equal values
+		case n > 1 && (v-rs[n-1])*(rs[n-1]-rs[n-2]) > 0:
+			// continue the same direction
+			rs[n-1] = v
+		default:
+			rs = append(rs, v)
+		}
+	}
+	return
+}
+
+// Rainflow - return histogram of force ranges for history of forces
+// in according to rainflow counting method ASTM E1049.
+// Half cycles are counted as 0.5 cycle.
+func Rainflow(history []Force) (ranges []Force, cycles []float64) {
+	add := func(r Force, c float64) {
+		for i := range ranges {
+			if ranges[i] == r {
+				cycles[i] += c
+				return
+			}
+		}
+		ranges = append(ranges, r)
+		cycles = append(cycles, c)
+	}
+	var stack []Force
+	for _, v := range reversals(history) {
+		stack = append(stack, v)
+		for len(stack) >= 3 {
+			n := len(stack)
+			x := Force(math.Abs(float64(stack[n-1] - stack[n-2])))
+			y := Force(math.Abs(float64(stack[n-2] - stack[n-3])))
+			if x < y {
+				break
+			}
+			if n == 3 {
+				// range contains the starting point
+				add(y, 0.5)
+				stack = stack[1:]
+				continue
+			}
+			add(y, 1.0)
+			stack = append(stack[:n-3], stack[n-1])
+		}
+	}
+	for i := 1; i < len(stack); i++ {
+		add(Force(math.Abs(float64(stack[i]-stack[i-1]))), 0.5)
+	}
+	return
+}
+
+// Spectrum - return load spectrum of stress ranges for history of
+// forces in bolt by rainflow counting method
+func (f Fatigue) Spectrum(history []Force) (sp Spectrum) {
+	ranges, cycles := Rainflow(history)
+	for i := range ranges {
+		sp = append(sp, Block{Range: f.StressRange(ranges[i]), Cycles: cycles[i]})
+	}
+	return
+}
+
+// Histogram - return spectrum with stress ranges grouped by bins with
+// width. Stress range of bin is upper bound of bin.
+func (sp Spectrum) Histogram(width Stress) (hist Spectrum) {
+	for _, bl := range sp {
+		r := Stress(math.Ceil(float64(bl.Range)/float64(width)) * float64(width))
+		found := false
+		for i := range hist {
+			if hist[i].Range == r {
+				hist[i].Cycles += bl.Cycles
+				found = true
+				break
+			}
+		}
+		if !found {
+			hist = append(hist, Block{Range: r, Cycles: bl.Cycles})
+		}
+	}
+	sort.Slice(hist, func(i, j int) bool { return hist[i].Range > hist[j].Range })
+	return
+}
@@ -0,0 +1,107 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleFatigue() {
+	f := bolt.Fatigue{
+		B:    bolt.New(bolt.D36, bolt.G8p8),
+		Mode: bolt.FatigueTension,
+	}
+	sp := bolt.Spectrum{
+		{Range: 40.e6, Cycles: 1.e5},
+		{Range: 25.e6, Cycles: 1.e6},
+		{Range: 10.e6, Cycles: 1.e7},
+	}
+	_, s := f.Value(sp, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of fatigue for HM36Cl8.8, bolt in tension, detail category 50, m = 3:
+	// 	γFf = 1.000
+	// 	γMf = 1.350
+	// 	ks  = 0.955
+	// 	100000 cycles of 40.0 MPa, endurance is 1384755 cycles
+	// 	1000000 cycles of 25.0 MPa, endurance is 6177490 cycles
+	// 	10000000 cycles of 10.0 MPa, endurance is +Inf cycles
+	// 	In according to A.5 EN1993-1-9:
+	// Damage is 0.234
+}
+
+func TestFatigue(t *testing.T) {
+	for _, mode := range []bolt.FatigueMode{bolt.FatigueTension, bolt.FatigueShear} {
+		f := bolt.Fatigue{
+			B:         bolt.New(bolt.D20, bolt.G8p8),
+			Mode:      mode,
+			Position:  bolt.UnthreadShear,
+			FactorγMf: 1.0,
+		}
+		// endurance at detail category is 2 million cycles
+		if n := f.Endurance(f.Category()); math.Abs(n-2.e6) > 1.0 {
+			t.Errorf("%s: endurance for detail category is %.0f", mode, n)
+		}
+		if n := f.Endurance(1.e6); !math.IsInf(n, 1) {
+			t.Errorf("%s: stress range below cut off limit have endurance %.0f", mode, n)
+		}
+		if d, _ := f.Value(nil, bolt.NoView); d != 0.0 {
+			t.Errorf("%s: damage of empty spectrum is not zero", mode)
+		}
+		sp := bolt.NewSpectrum([]bolt.Stress{f.Category(), f.Category()})
+		if d := f.Damage(sp); math.Abs(float64(d)-1.e-6) > 1e-12 {
+			t.Errorf("%s: damage is not correct: %s", mode, d)
+		}
+	}
+	// bolt in shear with shear plane through thread
+	thread := bolt.Fatigue{B: bolt.New(bolt.D20, bolt.G8p8), Mode: bolt.FatigueShear, Position: bolt.ThreadShear}
+	if err := thread.Validate(); err == nil {
+		t.Errorf("shear through thread is valid for fatigue")
+	}
+	if d, s := thread.Value(bolt.NewSpectrum([]bolt.Stress{10.e6}), bolt.NoView); !math.IsInf(float64(d), 1) || s == "" {
+		t.Errorf("shear through thread is passed: %s", d)
+	}
+	// size effect
+	f := bolt.Fatigue{B: bolt.New(bolt.D48, bolt.G8p8)}
+	if ks := f.Ks(); math.Abs(float64(ks)-math.Pow(30./48., 0.25)) > 1e-9 {
+		t.Errorf("size effect is not correct: %s", ks)
+	}
+}
+
+func ExampleRainflow() {
+	// history of bolt forces
+	history := []bolt.Force{0, 30e3, 10e3, 25e3, 5e3, 40e3, 0, 20e3, 15e3, 20e3, 0}
+	f := bolt.Fatigue{
+		B:    bolt.New(bolt.D20, bolt.G8p8),
+		Mode: bolt.FatigueTension,
+	}
+	for _, bl := range f.Spectrum(history).Histogram(5e6) {
+		fmt.Fprintf(os.Stdout, "%s\n", bl)
+	}
+
+	// Output:
+	// 1 cycles of 165.0 MPa
+	// 1 cycles of 105.0 MPa
+	// 1 cycles of 85.0 MPa
+	// 1 cycles of 65.0 MPa
+	// 1 cycles of 25.0 MPa
+}
+
+func TestRainflow(t *testing.T) {
+	// example from ASTM E1049, table X1.4
+	history := []bolt.Force{-2, 1, -3, 5, -1, 3, -4, 4, -2}
+	ranges, cycles := bolt.Rainflow(history)
+	expect := map[bolt.Force]float64{3: 0.5, 4: 1.5, 6: 0.5, 8: 1.0, 9: 0.5}
+	if len(ranges) != len(expect) {
+		t.Fatalf("not valid amount of ranges: %v %v", ranges, cycles)
+	}
+	for i := range ranges {
+		if c, ok := expect[ranges[i]]; !ok || c != cycles[i] {
+			t.Errorf("not valid cycles for range %v: %v", ranges[i], cycles[i])
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// FinPlate - beam-to-column fin plate (shear tab) connection.
+// Fin plate is welded to column and bolted to beam web by rectangular bolt
+// group in single shear. Connection is loaded by vertical shear force.
+// Steel and SteelWeb take precedence over Fy, Fu and FyWeb, FuWeb.
+// Unit - meter, Pa
+type FinPlate struct {
+	B        Bolt
+	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	Units    Units         // units of report, SI units if empty
+
+	N1 int       // amount of bolt rows (vertical)
+	P1 Dimension // vertical spacing of bolt rows
+	N2 int       // amount of bolt lines (horizontal)
+	P2 Dimension // horizontal spacing of bolt lines
+	Z  Dimension // distance from weld to centroid of bolt group
+
+	// Plate
+	Thk   Dimension // thickness of fin plate
+	E1    Dimension // vertical end distance on fin plate
+	E2    Dimension // horizontal edge distance on fin plate
+	Steel Steel     // steel grade of fin plate
+	Fy    Stress    // yield strength of fin plate
+	Fu    Stress    // ultimate tensile strength of fin plate
+
+	// Beam web
+	Tw       Dimension // thickness of beam web
+	E2b      Dimension // horizontal edge distance on beam web
+	SteelWeb Steel     // steel grade of beam web
+	FyWeb    Stress    // yield strength of beam web
+	FuWeb    Stress    // ultimate tensile strength of beam web
+
+	// Weld
+	A        Dimension // throat thickness of fillet weld on each side of plate
+	Factorβw Factor    // correlation factor of fillet weld
+}
+
+// Hp - depth of fin plate
+func (fp FinPlate) Hp() Dimension {
+	return Dimension(2.0*float64(fp.E1) + float64(fp.N1-1)*float64(fp.P1))
+}
+
+// boltGroupForces - return vertical and horizontal components of the
+// maximal bolt force in rectangular bolt group loaded by vertical force V,
+// horizontal force N and moment M about centroid of group.
+// unit: N
+func boltGroupForces(n1, n2 int, p1, p2 Dimension, V, N Force, M Moment) (fv, fh Force) {
+	var ip float64
+	for i := 0; i < n1; i++ {
+		for j := 0; j < n2; j++ {
+			y := (float64(i) - float64(n1-1)/2.0) * float64(p1)
+			x := (float64(j) - float64(n2-1)/2.0) * float64(p2)
+			ip += x*x + y*y
+		}
+	}
+	fv = Force(math.Abs(float64(V)) / float64(n1*n2))
+	fh = Force(math.Abs(float64(N)) / float64(n1*n2))
+	if ip == 0.0 {
+		return
+	}
+	xmax := float64(n2-1) * float64(p2) / 2.0
+	ymax := float64(n1-1) * float64(p1) / 2.0
+	fv += Force(math.Abs(float64(M)) * xmax / ip)
+	fh += Force(math.Abs(float64(M)) * ymax / ip)
+	return
+}
+
+// bearingInteraction - return equivalent bolt force and resistance for
+// bearing interaction (Fv/Fbv)² + (Fh/Fbh)² <= 1.
+// unit: N
+func bearingInteraction(fv, fh Force, bv, bh BearingResistance) (ed, rd Force) {
+	f := math.Hypot(float64(fv)/float64(bv.Value()), float64(fh)/float64(bh.Value()))
+	ed = Force(math.Hypot(float64(fv), float64(fh)))
+	if f == 0.0 {
+		return ed, Force(math.Min(float64(bv.Value()), float64(bh.Value())))
+	}
+	return ed, Force(float64(ed) / f)
+}
+
+// Checks - return report of all design checks for vertical shear force VEd.
+// Error is returned for not valid strength of fin plate or beam web.
+func (fp FinPlate) Checks(VEd Force) (r Report, err error) {
+	fv, fh := boltGroupForces(fp.N1, fp.N2, fp.P1, fp.P2, VEd, 0.0, Moment(float64(VEd)*float64(fp.Z)))
+	fEd := Force(math.Hypot(float64(fv), float64(fh)))
+	do := float64(fp.B.Do().Value())
+	hp := float64(fp.Hp())
+	tp := float64(fp.Thk)
+	fy, fu, err := fp.Steel.strength(fp.Thk, fp.Fy, fp.Fu)
+	if err != nil {
+		err = fmt.Errorf("fin plate: %v", err)
+	}
+	fyWeb, fuWeb, errWeb := fp.SteelWeb.strength(fp.Tw, fp.FyWeb, fp.FuWeb)
+	if err == nil && errWeb != nil {
+		err = fmt.Errorf("beam web: %v", errWeb)
+	}
+
+	// bolt group in single shear
+	r = append(r, Check{
+		Name: "Bolt shear with eccentricity, table 3.4 EN1993-1-8",
+		Ed:   fEd,
+		Rd:   ShearResistance{NA: fp.NA, B: fp.B, Position: fp.Position}.Value(),
+	})
+
+	// fin plate bearing
+	ed, rd := bearingInteraction(fv, fh,
+		BearingResistance{NA: fp.NA, B: fp.B, Thk: fp.Thk, Steel: fp.Steel, Fu: fp.Fu, E1: fp.E1, P1: fp.P1, E2: fp.E2, P2: fp.P2},
+		BearingResistance{NA: fp.NA, B: fp.B, Thk: fp.Thk, Steel: fp.Steel, Fu: fp.Fu, E1: fp.E2, P1: fp.P2, E2: fp.E1, P2: fp.P1})
+	r = append(r, Check{Name: "Fin plate bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// beam web bearing
+	ed, rd = bearingInteraction(fv, fh,
+		BearingResistance{NA: fp.NA, B: fp.B, Thk: fp.Tw, Steel: fp.SteelWeb, Fu: fp.FuWeb, P1: fp.P1, E2: fp.E2b, P2: fp.P2},
+		BearingResistance{NA: fp.NA, B: fp.B, Thk: fp.Tw, Steel: fp.SteelWeb, Fu: fp.FuWeb, E1: fp.E2b, P1: fp.P2, P2: fp.P1})
+	r = append(r, Check{Name: "Beam web bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// beam web shear at connection
+	tw := float64(fp.Tw)
+	r = append(r, Check{
+		Name: "Beam web shear of gross section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd:   Force(hp * tw * float64(fyWeb) / (math.Sqrt(3.0) * float64(fp.NA.get().FactorγM0))),
+	})
+	r = append(r, Check{
+		Name: "Beam web shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force((hp - float64(fp.N1)*do) * tw * float64(fuWeb) /
+			(math.Sqrt(3.0) * float64(fp.NA.get().FactorγM2))),
+	})
+
+	// fin plate shear
+	r = append(r, Check{
+		Name: "Fin plate shear of gross section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd:   Force(hp * tp / 1.27 * float64(fy) / (math.Sqrt(3.0) * float64(fp.NA.get().FactorγM0))),
+	})
+	r = append(r, Check{
+		Name: "Fin plate shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force((hp - float64(fp.N1)*do) * tp * float64(fu) /
+			(math.Sqrt(3.0) * float64(fp.NA.get().FactorγM2))),
+	})
+
+	// fin plate block tearing
+	r = append(r, Check{
+		Name: "Fin plate block tearing, 3.10.2 EN1993-1-8",
+		Ed:   VEd,
+		Rd: BlockTearing{
+			NA: fp.NA,
+			Ant: Area(tp * (float64(fp.E2) + float64(fp.N2-1)*float64(fp.P2) -
+				(float64(fp.N2)-0.5)*do)),
+			Anv:       Area(tp * (hp - float64(fp.E1) - (float64(fp.N1)-0.5)*do)),
+			Thk:       fp.Thk,
+			Steel:     fp.Steel,
+			Fy:        fp.Fy,
+			Fu:        fp.Fu,
+			Eccentric: true,
+		}.Value(),
+	})
+
+	// fin plate bending
+	if hp < 2.73*float64(fp.Z) {
+		r = append(r, Check{
+			Name: "Fin plate bending, 6.2.5 EN1993-1-1",
+			Ed:   VEd,
+			Rd:   Force(tp * hp * hp / 6.0 * float64(fy) / (float64(fp.Z) * float64(fp.NA.get().FactorγM0))),
+		})
+	}
+
+	// transverse fillet welds on both sides of fin plate develop
+	// the plate strength
+	fwd := float64(fu) / (math.Sqrt(2.0) * float64(fp.Factorβw) * float64(fp.NA.get().FactorγM2))
+	r = append(r, Check{
+		Name: "Weld of fin plate, 4.5.3.2 EN1993-1-8",
+		Ed:   Force(hp * tp * float64(fy) / float64(fp.NA.get().FactorγM0)),
+		Rd:   Force(2.0 * fwd * float64(fp.A) * hp),
+	})
+	return
+}
+
+// Value - return result of fin plate calculation for vertical shear force VEd
+func (fp FinPlate) Value(VEd Force, view ViewResult) (_ Factor, s string) {
+	u := fp.Units.get()
+	r, err := fp.Checks(VEd)
+	if err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of fin plate with bolts %s is not valid: %v\n", fp.B, err)
+	}
+	if view == FullView {
+		s += u.Sprintf("Calculation of fin plate with %dx%d bolts %s:\n", fp.N1, fp.N2, fp.B)
+		s += u.Sprintf("\tγM0 = %s\n", fp.NA.get().FactorγM0)
+		s += u.Sprintf("\tγM2 = %s\n", fp.NA.get().FactorγM2)
+		s += u.Sprintf("\thp  = %s\n", fp.Hp())
+		s += u.Sprintf("\ttp  = %s\n", fp.Thk)
+		s += u.Sprintf("\tVEd = %s\n", VEd)
+		s += r.In(u)
+	}
+	return r.Value(), s
+}
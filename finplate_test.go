@@ -0,0 +1,77 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func finPlate() bolt.FinPlate {
+	return bolt.FinPlate{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+		N1:       4,
+		P1:       70e-3,
+		N2:       1,
+		Z:        60e-3,
+		Thk:      10e-3,
+		E1:       40e-3,
+		E2:       50e-3,
+		Fy:       275e6,
+		Fu:       410e6,
+		Tw:       8.6e-3,
+		E2b:      40e-3,
+		FyWeb:    275e6,
+		FuWeb:    410e6,
+		A:        6e-3,
+		Factorβw: 0.85,
+	}
+}
+
+func ExampleFinPlate() {
+	fp := finPlate()
+	_, s := fp.Value(200e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of fin plate with 4x1 bolts HM20Cl8.8:
+	// 	γM0 = 1.000
+	// 	γM2 = 1.250
+	// 	hp  = 290.0 mm
+	// 	tp  = 10.0 mm
+	// 	VEd = 200.0 kN
+	// 	Bolt shear with eccentricity, table 3.4 EN1993-1-8: Ed = 71.7 kN, Rd = 94.1 kN, factor 0.762
+	// 	Fin plate bearing, table 3.4 EN1993-1-8: Ed = 71.7 kN, Rd = 110.1 kN, factor 0.651
+	// 	Beam web bearing, table 3.4 EN1993-1-8: Ed = 71.7 kN, Rd = 96.4 kN, factor 0.744
+	// 	Beam web shear of gross section, 6.2.6 EN1993-1-1: Ed = 200.0 kN, Rd = 396.0 kN, factor 0.505
+	// 	Beam web shear of net section, 6.2.6 EN1993-1-1: Ed = 200.0 kN, Rd = 329.0 kN, factor 0.608
+	// 	Fin plate shear of gross section, 6.2.6 EN1993-1-1: Ed = 200.0 kN, Rd = 362.5 kN, factor 0.552
+	// 	Fin plate shear of net section, 6.2.6 EN1993-1-1: Ed = 200.0 kN, Rd = 382.5 kN, factor 0.523
+	// 	Fin plate block tearing, 3.10.2 EN1993-1-8: Ed = 200.0 kN, Rd = 338.6 kN, factor 0.591
+	// 	Weld of fin plate, 4.5.3.2 EN1993-1-8: Ed = 797.5 kN, Rd = 949.6 kN, factor 0.840
+	// Summary factor is 0.840
+}
+
+func TestFinPlate(t *testing.T) {
+	fp := finPlate()
+	if f, _ := fp.Value(1e10, bolt.NoView); float64(f) < 1.0 {
+		t.Errorf("Factor can not be less 1.0 if load is huge")
+	}
+	r, err := fp.Checks(1e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range r {
+		if c.Rd <= 0.0 {
+			t.Errorf("Resistance is not positive: %s", c)
+		}
+	}
+	twoLines := fp
+	twoLines.N2 = 2
+	twoLines.P2 = 60e-3
+	if r2, _ := twoLines.Checks(1e3); r2[0].Rd != r[0].Rd {
+		t.Errorf("Shear resistance of bolt is not same")
+	}
+}
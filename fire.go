@@ -0,0 +1,211 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Temperature - temperature in degree Celsius
+type Temperature float64
+
+func (t Temperature) String() string {
+	return fmt.Sprintf("%.0f°C", float64(t))
+}
+
+// kbθ - strength reduction factor for bolts at elevated temperature
+// in according to table D.1 EN1993-1-2
+var kbθ = []struct {
+	θ Temperature
+	k Factor
+}{
+	{θ: 20, k: 1.000},
+	{θ: 100, k: 0.968},
+	{θ: 150, k: 0.952},
+	{θ: 200, k: 0.935},
+	{θ: 300, k: 0.903},
+	{θ: 400, k: 0.775},
+	{θ: 500, k: 0.550},
+	{θ: 600, k: 0.220},
+	{θ: 700, k: 0.100},
+	{θ: 800, k: 0.067},
+	{θ: 900, k: 0.033},
+	{θ: 1000, k: 0.000},
+}
+
+// FactorKbθ - return strength reduction factor for bolts in tension and
+// shear at temperature by linear interpolation of table D.1 EN1993-1-2
+func FactorKbθ(θ Temperature) Factor {
+	if θ <= kbθ[0].θ {
+		return kbθ[0].k
+	}
+	for i := 1; i < len(kbθ); i++ {
+		if θ <= kbθ[i].θ {
+			a, b := kbθ[i-1], kbθ[i]
+			return a.k + (b.k-a.k)*Factor((θ-a.θ)/(b.θ-a.θ))
+		}
+	}
+	return kbθ[len(kbθ)-1].k
+}
+
+// TemperaturePoint - point of temperature-time curve
+type TemperaturePoint struct {
+	Time time.Duration // time from start of fire
+	T    Temperature   // temperature of bolt
+}
+
+// TemperatureCurve - temperature-time curve of bolt.
+// Points must be sorted by time.
+type TemperatureCurve []TemperaturePoint
+
+// At - return temperature at time by linear interpolation of curve.
+// Temperature is 20°C for empty curve and is constant outside of curve.
+func (tc TemperatureCurve) At(t time.Duration) Temperature {
+	if len(tc) == 0 {
+		return 20.0
+	}
+	if t <= tc[0].Time {
+		return tc[0].T
+	}
+	for i := 1; i < len(tc); i++ {
+		if t <= tc[i].Time {
+			a, b := tc[i-1], tc[i]
+			return a.T + (b.T-a.T)*Temperature(float64(t-a.Time)/float64(b.Time-a.Time))
+		}
+	}
+	return tc[len(tc)-1].T
+}
+
+// Fire - resistance of bolt at elevated temperature in according to
+// annex D EN1993-1-2. Bearing resistance is checked only if thickness
+// of plate is not zero.
+type Fire struct {
+	B        Bolt
+	BT       Type
+	Position PositionShear
+	NA       NationalAnnex     // national annex, recommended values if empty
+	Units    Units             // units of report, SI units if empty
+	Bearing  BearingResistance // bearing resistance of plate at normal temperature
+}
+
+// factor - return reduction factor of resistance at normal temperature
+func (f Fire) factor(θ Temperature) Factor {
+	na := f.NA.get()
+	return FactorKbθ(θ) * na.FactorγM2 / na.FactorγMfi
+}
+
+// ShearResistance - return design shear resistance of bolt at temperature
+// in according to D.1 EN1993-1-2
+func (f Fire) ShearResistance(θ Temperature) Force {
+	sr := ShearResistance{B: f.B, Position: f.Position, NA: f.NA}
+	return sr.Value() * Force(f.factor(θ))
+}
+
+// TensionResistance - return design tension resistance of bolt at
+// temperature in according to D.1 EN1993-1-2
+func (f Fire) TensionResistance(θ Temperature) Force {
+	tr := TensionResistance{B: f.B, BT: f.BT, NA: f.NA}
+	return tr.Value() * Force(f.factor(θ))
+}
+
+// BearingResistance - return design bearing resistance of plate per bolt
+// at temperature in according to D.1 EN1993-1-2
+func (f Fire) BearingResistance(θ Temperature) Force {
+	br := f.Bearing
+	br.B, br.NA = f.B, f.NA
+	return br.Value() * Force(f.factor(θ))
+}
+
+// Value - return result of combined resistance calculation at temperature
+func (f Fire) Value(FvEd, FtEd Force, θ Temperature, view ViewResult) (_ Factor, s string) {
+	u := f.Units.get()
+	na := f.NA.get()
+	FvRd := f.ShearResistance(θ)
+	FtRd := f.TensionResistance(θ)
+	if view == FullView {
+		s += u.Sprintf("Calculation of fire resistance for %s at %s:\n", f.B, θ)
+		s += u.Sprintf("\tγM2   = %s\n", na.FactorγM2)
+		s += u.Sprintf("\tγM,fi = %s\n", na.FactorγMfi)
+		s += u.Sprintf("\tkb,θ  = %s\n", FactorKbθ(θ))
+		s += "\tIn according to D.1 EN1993-1-2:\n"
+		s += u.Sprintf("\tShear resistance is %s\n", FvRd)
+		s += u.Sprintf("\tTension resistance is %s\n", FtRd)
+	}
+	max := math.Max(ratio(FvEd, FvRd), ratio(FtEd, FtRd))
+	max = math.Max(max, ratio(FvEd, FvRd)+ratio(FtEd, 1.4*FtRd))
+	if f.Bearing.Thk != 0.0 {
+		FbRd := f.BearingResistance(θ)
+		if view == FullView {
+			s += u.Sprintf("\tBearing resistance is %s\n", FbRd)
+		}
+		max = math.Max(max, ratio(FvEd, FbRd))
+	}
+	if view == FullView {
+		s += u.Sprintf("Summary factor of combined loads is %s\n", Factor(max))
+	}
+	return Factor(max), s
+}
+
+// Curve - return result of combined resistance calculation for
+// temperature-time curve. Result is maximal factor for all points
+// of curve.
+func (f Fire) Curve(FvEd, FtEd Force, tc TemperatureCurve, view ViewResult) (_ Factor, s string) {
+	u := f.Units.get()
+	var max Factor
+	for _, tp := range tc {
+		factor, _ := f.Value(FvEd, FtEd, tp.T, NoView)
+		if view == FullView {
+			s += u.Sprintf("Time %s, temperature %s, factor %s\n", tp.Time, tp.T, factor)
+		}
+		if max < factor {
+			max = factor
+		}
+	}
+	if view == FullView {
+		s += u.Sprintf("Summary factor of temperature-time curve is %s\n", max)
+	}
+	return max, s
+}
+
+// ConnectionTemperature - return temperature of connection component
+// at height h from bottom of beam with depth D in according to D.3
+// EN1993-1-2. Temperature θ0 is temperature of bottom flange of beam
+// at mid span.
+func ConnectionTemperature(θ0 Temperature, h, D Dimension) Temperature {
+	if D <= 400.e-3 {
+		return Temperature(0.88 * float64(θ0) * (1.0 - 0.3*float64(h/D)))
+	}
+	if h <= D/2.0 {
+		return Temperature(0.88 * float64(θ0))
+	}
+	return Temperature(0.88 * float64(θ0) * (1.0 + 0.2*(1.0-2.0*float64(h/D))))
+}
+
+// BoltRow - row of bolts in beam-to-column connection
+type BoltRow struct {
+	H    Dimension // height of row from bottom of beam
+	FvEd Force     // design shear force per bolt
+	FtEd Force     // design tension force per bolt
+}
+
+// Rows - return result of fire resistance calculation for rows of bolts
+// in beam-to-column connection of beam with depth D. Temperature of each
+// row is calculated by temperature θ0 of bottom flange of beam.
+func (f Fire) Rows(θ0 Temperature, D Dimension, rows []BoltRow, view ViewResult) (_ Factor, s string) {
+	u := f.Units.get()
+	var max Factor
+	for i, row := range rows {
+		θ := ConnectionTemperature(θ0, row.H, D)
+		factor, _ := f.Value(row.FvEd, row.FtEd, θ, NoView)
+		if view == FullView {
+			s += u.Sprintf("Row %d at height %s, temperature %s, factor %s\n", i+1, row.H, θ, factor)
+		}
+		if max < factor {
+			max = factor
+		}
+	}
+	if view == FullView {
+		s += u.Sprintf("Summary factor of bolt rows is %s\n", max)
+	}
+	return max, s
+}
@@ -0,0 +1,142 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleFire() {
+	f := bolt.Fire{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+		Bearing: bolt.BearingResistance{
+			Thk: 10e-3,
+			Fu:  360e6,
+			E1:  40e-3,
+			E2:  40e-3,
+		},
+	}
+	_, s := f.Value(20e3, 10e3, 500, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	tc := bolt.TemperatureCurve{
+		{Time: 0, T: 20},
+		{Time: 15 * time.Minute, T: 350},
+		{Time: 30 * time.Minute, T: 550},
+	}
+	_, s = f.Curve(20e3, 10e3, tc, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of fire resistance for HM20Cl8.8 at 500°C:
+	// 	γM2   = 1.250
+	// 	γM,fi = 1.000
+	// 	kb,θ  = 0.550
+	// 	In according to D.1 EN1993-1-2:
+	// 	Shear resistance is 64.7 kN
+	// 	Tension resistance is 97.0 kN
+	// 	Bearing resistance is 60.0 kN
+	// Summary factor of combined loads is 0.383
+	// Time 0s, temperature 20°C, factor 0.211
+	// Time 15m0s, temperature 350°C, factor 0.251
+	// Time 30m0s, temperature 550°C, factor 0.547
+	// Summary factor of temperature-time curve is 0.547
+}
+
+func ExampleFire_Rows() {
+	f := bolt.Fire{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+	}
+	rows := []bolt.BoltRow{
+		{H: 50e-3, FvEd: 15e3},
+		{H: 150e-3, FvEd: 15e3},
+		{H: 250e-3, FvEd: 15e3, FtEd: 20e3},
+	}
+	_, s := f.Rows(600, 300e-3, rows, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Row 1 at height 50.0 mm, temperature 502°C, factor 0.234
+	// Row 2 at height 150.0 mm, temperature 449°C, factor 0.192
+	// Row 3 at height 250.0 mm, temperature 396°C, factor 0.267
+	// Summary factor of bolt rows is 0.267
+}
+
+func TestConnectionTemperature(t *testing.T) {
+	tcs := []struct {
+		h, D bolt.Dimension
+		θ    bolt.Temperature
+	}{
+		{h: 0, D: 300e-3, θ: 528},
+		{h: 300e-3, D: 300e-3, θ: 369.6},
+		{h: 200e-3, D: 600e-3, θ: 528},
+		{h: 600e-3, D: 600e-3, θ: 422.4},
+	}
+	for _, tc := range tcs {
+		if θ := bolt.ConnectionTemperature(600, tc.h, tc.D); math.Abs(float64(θ-tc.θ)) > 1e-6 {
+			t.Errorf("temperature at %s for beam %s is %v, but expect %v", tc.h, tc.D, θ, tc.θ)
+		}
+	}
+}
+
+func TestFactorKbθ(t *testing.T) {
+	tcs := []struct {
+		θ bolt.Temperature
+		k bolt.Factor
+	}{
+		{θ: 0, k: 1.0},
+		{θ: 20, k: 1.0},
+		{θ: 250, k: 0.919},
+		{θ: 600, k: 0.220},
+		{θ: 1200, k: 0.0},
+	}
+	for _, tc := range tcs {
+		if k := bolt.FactorKbθ(tc.θ); math.Abs(float64(k-tc.k)) > 1e-9 {
+			t.Errorf("factor for %s is %s, but expect %s", tc.θ, k, tc.k)
+		}
+	}
+}
+
+func TestFire(t *testing.T) {
+	f := bolt.Fire{B: bolt.New(bolt.D16, bolt.G10p9)}
+	// at normal temperature
+	sr := bolt.ShearResistance{B: f.B}
+	if fv := f.ShearResistance(20) * 1.0 / 1.25; math.Abs(float64(fv-sr.Value())) > 1e-6 {
+		t.Errorf("not valid shear resistance at normal temperature: %s != %s", fv, sr.Value())
+	}
+	if v, _ := f.Value(1, 1, 1000, bolt.NoView); !math.IsInf(float64(v), 1) {
+		t.Errorf("bolt have strength at 1000°C: %s", v)
+	}
+	tc := bolt.TemperatureCurve{
+		{Time: 0, T: 20},
+		{Time: 10 * time.Minute, T: 420},
+		{Time: 20 * time.Minute, T: 220},
+	}
+	for _, c := range []struct {
+		t time.Duration
+		θ bolt.Temperature
+	}{
+		{t: -time.Minute, θ: 20},
+		{t: 5 * time.Minute, θ: 220},
+		{t: 10 * time.Minute, θ: 420},
+		{t: 15 * time.Minute, θ: 320},
+		{t: time.Hour, θ: 220},
+	} {
+		if θ := tc.At(c.t); math.Abs(float64(θ-c.θ)) > 1e-9 {
+			t.Errorf("temperature at %s is %v, but expect %v", c.t, θ, c.θ)
+		}
+	}
+	if θ := (bolt.TemperatureCurve{}).At(time.Minute); θ != 20 {
+		t.Errorf("temperature of empty curve is %v", θ)
+	}
+	fc, _ := f.Curve(10e3, 0.0, tc, bolt.NoView)
+	if fv, _ := f.Value(10e3, 0.0, 420, bolt.NoView); fc != fv {
+		t.Errorf("factor of curve is not factor at maximal temperature: %s != %s", fc, fv)
+	}
+}
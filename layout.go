@@ -0,0 +1,102 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// Layout - rectangular layout of bolts in plate.
+// Direction 1 is direction of vertical force.
+type Layout struct {
+	N1     int       // amount of bolt rows in direction 1
+	P1     Dimension // spacing of bolts in direction 1
+	E1     Dimension // end distance in direction 1
+	N2     int       // amount of bolt columns in direction 2
+	P2     Dimension // spacing of bolts in direction 2
+	E2     Dimension // edge distance in direction 2
+	Factor Factor    // utilisation of layout
+}
+
+func (l Layout) String() string {
+	return fmt.Sprintf("%d x %d bolts, e1 = %s, p1 = %s, e2 = %s, p2 = %s, factor %s",
+		l.N1, l.N2, l.E1, l.P1, l.E2, l.P2, l.Factor)
+}
+
+// LayoutPlate - rectangular plate for search of bolt layout.
+// Plate is loaded by vertical force V, horizontal force N and moment M
+// about centroid of plate.
+type LayoutPlate struct {
+	B        Bolt
+	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	H        Dimension     // height of plate in direction 1
+	W        Dimension     // width of plate in direction 2
+	Thk      Dimension     // thickness of plate
+	Steel    Steel         // steel grade of plate, Fu is used if empty
+	Fu       Stress        // ultimate tensile strength of plate
+	Exposure Exposure      // exposure condition for maximal distances
+}
+
+// spacing - return spacing and edge distance for n bolts in length with
+// bounds of distances. Spacing is rounded down to 5 mm.
+func spacing(n int, length, emin, emax, pmin, pmax Dimension) (p, e Dimension, ok bool) {
+	if n == 1 {
+		e = length / 2.0
+		return 0.0, e, emin <= e && e <= emax
+	}
+	p = Dimension(math.Min(float64(pmax), float64(length-2.0*emin)/float64(n-1)))
+	p = Dimension(math.Floor(float64(p)/5e-3+1e-9) * 5e-3)
+	e = (length - Dimension(n-1)*p) / 2.0
+	return p, e, pmin <= p && emin <= e && e <= emax
+}
+
+// factor - return utilisation of bolt layout
+func (lp LayoutPlate) factor(l Layout, V, N Force, M Moment) Factor {
+	fv, fh := boltGroupForces(l.N1, l.N2, l.P1, l.P2, V, N, M)
+	sr := ShearResistance{B: lp.B, Position: lp.Position, NA: lp.NA}
+	f := ratio(Force(math.Hypot(float64(fv), float64(fh))), sr.Value())
+	bv := BearingResistance{B: lp.B, Thk: lp.Thk, Steel: lp.Steel, Fu: lp.Fu, E1: l.E1, P1: l.P1, E2: l.E2, P2: l.P2, NA: lp.NA}
+	bh := BearingResistance{B: lp.B, Thk: lp.Thk, Steel: lp.Steel, Fu: lp.Fu, E1: l.E2, P1: l.P2, E2: l.E1, P2: l.P1, NA: lp.NA}
+	ed, rd := bearingInteraction(fv, fh, bv, bh)
+	return Factor(math.Max(f, ratio(ed, rd)))
+}
+
+// Layout - return layout with the lowest bolt count with utilisation not
+// more 1.0. Spacings and edge distances are inside bounds of table 3.3
+// EN1993-1-8 given by GetDistances, bolts are placed with maximal spacing
+// for the best resistance to moment.
+func (lp LayoutPlate) Layout(V, N Force, M Moment) (best Layout, err error) {
+	if _, err = lp.Steel.ultimate(lp.Thk, lp.Fu); err != nil {
+		return
+	}
+	d := GetDistances(lp.B, lp.Thk, lp.Exposure)
+	const maxBolts = 100
+	for n := 1; n <= maxBolts; n++ {
+		found := false
+		for n1 := 1; n1 <= n; n1++ {
+			if n%n1 != 0 {
+				continue
+			}
+			l := Layout{N1: n1, N2: n / n1}
+			var ok1, ok2 bool
+			l.P1, l.E1, ok1 = spacing(l.N1, lp.H, d.E1min(), d.E1max(), d.P1min(), d.P1max())
+			l.P2, l.E2, ok2 = spacing(l.N2, lp.W, d.E2min(), d.E2max(), d.P2min(), d.P2max())
+			if !ok1 || !ok2 {
+				continue
+			}
+			l.Factor = lp.factor(l, V, N, M)
+			if 1.0 < l.Factor {
+				continue
+			}
+			if !found || l.Factor < best.Factor {
+				best = l
+				found = true
+			}
+		}
+		if found {
+			return
+		}
+	}
+	err = fmt.Errorf("layout of bolts %s is not found", lp.B)
+	return
+}
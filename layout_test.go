@@ -0,0 +1,57 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleLayoutPlate() {
+	lp := bolt.LayoutPlate{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+		H:        300e-3,
+		W:        150e-3,
+		Thk:      12e-3,
+		Fu:       360e6,
+	}
+	l, err := lp.Layout(200e3, 50e3, 15e3)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", l)
+
+	// Output:
+	// 2 x 2 bolts, e1 = 67.5 mm, p1 = 165.0 mm, e2 = 27.5 mm, p2 = 95.0 mm, factor 0.891
+}
+
+func TestLayoutPlate(t *testing.T) {
+	lp := bolt.LayoutPlate{
+		B:   bolt.New(bolt.D16, bolt.G8p8),
+		H:   250e-3,
+		W:   100e-3,
+		Thk: 10e-3,
+		Fu:  360e6,
+	}
+	d := bolt.GetDistances(lp.B, lp.Thk, lp.Exposure)
+	for _, V := range []bolt.Force{10e3, 100e3, 200e3} {
+		l, err := lp.Layout(V, 0, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", V, err)
+		}
+		if l.Factor > 1.0 {
+			t.Errorf("not valid factor: %s", l)
+		}
+		if l.E1 < d.E1min() || d.E1max() < l.E1 || l.E2 < d.E2min() || d.E2max() < l.E2 {
+			t.Errorf("edge distances are outside of bounds: %s", l)
+		}
+		if 1 < l.N1 && (l.P1 < d.P1min() || d.P1max() < l.P1) {
+			t.Errorf("spacing is outside of bounds: %s", l)
+		}
+	}
+	if _, err := lp.Layout(1e8, 0, 0); err == nil {
+		t.Errorf("layout for huge load is found")
+	}
+}
@@ -0,0 +1,116 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// standardLengths - preferred nominal lengths of bolts in millimeters
+// in according to ISO 4014, ISO 4017 and EN14399
+var standardLengths = []float64{
+	30, 35, 40, 45, 50, 55, 60, 65, 70, 75, 80, 85, 90, 95, 100,
+	110, 120, 130, 140, 150, 160, 170, 180, 190, 200,
+	220, 240, 260, 280, 300, 320, 340, 360, 380, 400,
+}
+
+// threadHV - reference thread length of bolts in according to EN14399-4
+var threadHV = map[Diameter]Dimension{
+	D12: 21e-3,
+	D16: 26e-3,
+	D20: 31e-3,
+	D24: 36e-3,
+	D30: 40e-3,
+	D36: 45e-3,
+}
+
+// ThreadLength - return reference thread length of bolt with length L
+func (a Assembly) ThreadLength(L Dimension) Dimension {
+	d := Dimension(a.B.D())
+	switch a.Bolt {
+	case ISO4017:
+		return L
+	case EN14399p4:
+		if b, ok := threadHV[a.B.D()]; ok {
+			return b
+		}
+	}
+	switch {
+	case L <= 125e-3:
+		return Dimension(math.Min(float64(L), float64(2.0*d+6e-3)))
+	case L <= 200e-3:
+		return 2.0*d + 12e-3
+	}
+	return 2.0*d + 25e-3
+}
+
+// BoltLength - result of bolt length selection
+type BoltLength struct {
+	L        Dimension     // standard length of bolt
+	Thread   Dimension     // thread length of bolt
+	Position PositionShear // assumed position of shear planes
+	Holds    bool          // assumption of position of shear planes holds
+}
+
+func (bl BoltLength) String() string {
+	s := fmt.Sprintf("Bolt length %s, thread length %s, ", bl.L, bl.Thread)
+	if bl.Holds {
+		return s + fmt.Sprintf("assumption is valid: %s", bl.Position)
+	}
+	return s + fmt.Sprintf("assumption is not valid: %s", bl.Position)
+}
+
+// Length - return the shortest standard length of bolt for clamped plies
+// in according to 8.2.2 EN1090-2. The thread protrusion is at least one
+// full thread, the clear threads between nut and shank are at least four
+// for preloaded bolts and one for other bolts. If shear planes must pass
+// through the unthreaded portion of the bolt, then the shortest length
+// with shear planes in the shank is selected, otherwise the assumption
+// does not hold. First washer is placed under nut, second washer is
+// placed under head of bolt. Shear planes are between plies.
+func (a Assembly) Length(plies []Dimension, position PositionShear, preloaded bool) (bl BoltLength, err error) {
+	_, nut, w, err := a.Components()
+	if err != nil {
+		return
+	}
+	var grip Dimension
+	for _, t := range plies {
+		grip += t
+	}
+	grip += Dimension(a.Washers) * w.H
+	pitch := Pinch{Dia: a.B.D()}.Value()
+	threads := 1.0
+	if preloaded {
+		threads = 4.0
+	}
+	// distance from head of bolt to the last shear plane
+	z := Dimension(0.0)
+	if 1 < a.Washers {
+		z = w.H
+	}
+	for i := 0; i+1 < len(plies); i++ {
+		z += plies[i]
+	}
+	found := false
+	for _, l := range standardLengths {
+		L := Dimension(l * 1e-3)
+		b := a.ThreadLength(L)
+		if L < grip+Dimension(a.Nuts)*nut.K+pitch {
+			continue
+		}
+		if L-b > grip-Dimension(threads)*pitch {
+			continue
+		}
+		holds := position == ThreadShear || z <= L-b
+		if !found || holds {
+			bl = BoltLength{L: L, Thread: b, Position: position, Holds: holds}
+			found = true
+		}
+		if holds {
+			break
+		}
+	}
+	if !found {
+		err = fmt.Errorf("standard length of bolt for grip %s is not found", grip)
+	}
+	return
+}
@@ -0,0 +1,64 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleAssembly_Length() {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D20, bolt.G8p8),
+		Bolt:    bolt.ISO4014,
+		Nut:     bolt.ISO4032,
+		Nuts:    1,
+		Washer:  bolt.ISO7089,
+		Washers: 2,
+	}
+	plies := []bolt.Dimension{20e-3, 12e-3}
+	for _, pos := range []bolt.PositionShear{bolt.ThreadShear, bolt.UnthreadShear} {
+		bl, err := a.Length(plies, pos, false)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Fprintf(os.Stdout, "%s\n", bl)
+	}
+	bl, err := a.Length([]bolt.Dimension{8e-3, 8e-3}, bolt.UnthreadShear, true)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", bl)
+
+	// Output:
+	// Bolt length 60.0 mm, thread length 46.0 mm, assumption is valid: Shear plane passes through the threaded portion of the bolt
+	// Bolt length 70.0 mm, thread length 46.0 mm, assumption is valid: Shear plane passes through the unthreaded portion of the bolt
+	// Bolt length 45.0 mm, thread length 45.0 mm, assumption is not valid: Shear plane passes through the unthreaded portion of the bolt
+}
+
+func TestAssemblyLength(t *testing.T) {
+	a := bolt.Assembly{
+		B:       bolt.New(bolt.D16, bolt.G10p9),
+		Bolt:    bolt.EN14399p4,
+		Nut:     bolt.EN14399p4,
+		Nuts:    1,
+		Washer:  bolt.EN14399p6,
+		Washers: 2,
+	}
+	plies := []bolt.Dimension{15e-3, 15e-3}
+	bl, err := a.Length(plies, bolt.UnthreadShear, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grip := plies[0] + plies[1] + 2*4e-3
+	if bl.L < grip+13e-3 {
+		t.Errorf("bolt is short: %s", bl)
+	}
+	if bl.L-bl.Thread > grip-4*2e-3 {
+		t.Errorf("not enough clear threads: %s", bl)
+	}
+	if _, err := a.Length([]bolt.Dimension{1.0}, bolt.ThreadShear, true); err == nil {
+		t.Errorf("bolt for huge grip is found")
+	}
+}
@@ -0,0 +1,268 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Direction - direction of load transfer
+type Direction bool
+
+// Constants of direction of load transfer
+const (
+	AlongX Direction = false
+	AlongY           = true
+)
+
+// Point - coordinates of center of hole
+type Point struct {
+	X, Y Dimension
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%s, %s)", p.X, p.Y)
+}
+
+// Pattern - pattern of bolt holes in rectangular plate
+type Pattern struct {
+	B         Bolt
+	Holes     []Point   // centers of holes
+	Min, Max  Point     // corners of plate
+	Thk       Dimension // thickness of the thinner outer connected part
+	Direction Direction // direction of load transfer
+	Exposure  Exposure
+	Tension   bool // tension member with staggered spacing of figure 3.1 EN1993-1-8
+}
+
+// Violation - violation of limit of distances between holes and plate edges
+type Violation struct {
+	Name  string    // name of distance
+	A, B  Point     // coordinates of holes, second hole is not used for edge distances
+	Value Dimension // actual distance
+	Limit Dimension // limit of distance
+}
+
+func (v Violation) String() string {
+	compare := "more"
+	if v.Value < v.Limit {
+		compare = "less"
+	}
+	at := v.A.String()
+	if v.Name != "e1" && v.Name != "e2" {
+		at += " and " + v.B.String()
+	}
+	return fmt.Sprintf("%s = %s is %s %s at %s", v.Name, v.Value, compare, v.Limit, at)
+}
+
+// uv - return coordinates along and perpendicular of load transfer
+func (p Pattern) uv(pt Point) (u, v Dimension) {
+	if p.Direction == AlongY {
+		return pt.Y, pt.X
+	}
+	return pt.X, pt.Y
+}
+
+// Validate - return all violations of pattern in according to table 3.3
+// and figure 3.1 EN1993-1-8. Holes are in lines, if coordinates are equal.
+// Staggered holes must have the distance between holes L >= 2.4d0 and
+// the spacing p2 >= 1.2d0.
+func (p Pattern) Validate() (vs []Violation) {
+	const eps = 1e-6
+	d := GetDistances(p.B, p.Thk, p.Exposure)
+	do := Dimension(p.B.Do().Value())
+	umin, vmin := p.uv(p.Min)
+	umax, vmax := p.uv(p.Max)
+	add := func(name string, a, b Point, value, limit Dimension) {
+		vs = append(vs, Violation{Name: name, A: a, B: b, Value: value, Limit: limit})
+	}
+
+	// lines of holes parallel and perpendicular to load transfer
+	lines := map[int64][]Point{}
+	columns := map[int64][]Point{}
+	key := func(x Dimension) int64 { return int64(math.Round(float64(x) / eps)) }
+	for _, h := range p.Holes {
+		u, v := p.uv(h)
+		lines[key(v)] = append(lines[key(v)], h)
+		columns[key(u)] = append(columns[key(u)], h)
+
+		// minimal edge distances
+		if e1 := Dimension(math.Min(float64(u-umin), float64(umax-u))); e1 < d.E1min() {
+			add("e1", h, Point{}, e1, d.E1min())
+		}
+		if e2 := Dimension(math.Min(float64(v-vmin), float64(vmax-v))); e2 < d.E2min() {
+			add("e2", h, Point{}, e2, d.E2min())
+		}
+	}
+
+	// maximal edge distances of the nearest holes to edges of plate
+	if 0 < len(p.Holes) {
+		near := [4]Point{p.Holes[0], p.Holes[0], p.Holes[0], p.Holes[0]}
+		for _, h := range p.Holes {
+			u, v := p.uv(h)
+			if u0, _ := p.uv(near[0]); u < u0 {
+				near[0] = h
+			}
+			if u1, _ := p.uv(near[1]); u1 < u {
+				near[1] = h
+			}
+			if _, v0 := p.uv(near[2]); v < v0 {
+				near[2] = h
+			}
+			if _, v1 := p.uv(near[3]); v1 < v {
+				near[3] = h
+			}
+		}
+		u0, _ := p.uv(near[0])
+		u1, _ := p.uv(near[1])
+		_, v0 := p.uv(near[2])
+		_, v1 := p.uv(near[3])
+		for i, e := range []Dimension{u0 - umin, umax - u1, v0 - vmin, vmax - v1} {
+			name, limit := "e1", d.E1max()
+			if 2 <= i {
+				name, limit = "e2", d.E2max()
+			}
+			if limit < e {
+				add(name, near[i], Point{}, e, limit)
+			}
+		}
+	}
+
+	// spacing in lines parallel to load transfer
+	outer := [2]int64{math.MaxInt64, math.MinInt64}
+	for k := range lines {
+		if k < outer[0] {
+			outer[0] = k
+		}
+		if outer[1] < k {
+			outer[1] = k
+		}
+	}
+	for k, line := range lines {
+		sort.Slice(line, func(i, j int) bool {
+			ui, _ := p.uv(line[i])
+			uj, _ := p.uv(line[j])
+			return ui < uj
+		})
+		name, limit := "p1", d.P1max()
+		if p.Tension {
+			name, limit = "p1,i", d.P1imax()
+			if k == outer[0] || k == outer[1] {
+				name, limit = "p1,0", d.P10max()
+			}
+		}
+		for i := 1; i < len(line); i++ {
+			ua, _ := p.uv(line[i-1])
+			ub, _ := p.uv(line[i])
+			if p1 := ub - ua; p1 < d.P1min() {
+				add("p1", line[i-1], line[i], p1, d.P1min())
+			} else if limit < p1 {
+				add(name, line[i-1], line[i], p1, limit)
+			}
+		}
+	}
+
+	// spacing in lines perpendicular to load transfer
+	for _, column := range columns {
+		sort.Slice(column, func(i, j int) bool {
+			_, vi := p.uv(column[i])
+			_, vj := p.uv(column[j])
+			return vi < vj
+		})
+		for i := 1; i < len(column); i++ {
+			_, va := p.uv(column[i-1])
+			_, vb := p.uv(column[i])
+			if p2 := vb - va; p2 < d.P2min() {
+				add("p2", column[i-1], column[i], p2, d.P2min())
+			} else if d.P2max() < p2 {
+				add("p2", column[i-1], column[i], p2, d.P2max())
+			}
+		}
+	}
+
+	// staggered holes
+	for i := range p.Holes {
+		for j := i + 1; j < len(p.Holes); j++ {
+			ua, va := p.uv(p.Holes[i])
+			ub, vb := p.uv(p.Holes[j])
+			du := Dimension(math.Abs(float64(ub - ua)))
+			dv := Dimension(math.Abs(float64(vb - va)))
+			if du < eps || dv < eps || d.P1min() <= du || d.P2min() <= dv {
+				continue
+			}
+			if dv < 1.2*do {
+				add("p2", p.Holes[i], p.Holes[j], dv, 1.2*do)
+			}
+			if L := Dimension(math.Hypot(float64(du), float64(dv))); L < 2.4*do {
+				add("L", p.Holes[i], p.Holes[j], L, 2.4*do)
+			}
+		}
+	}
+	sort.SliceStable(vs, func(i, j int) bool {
+		if vs[i].Name != vs[j].Name {
+			return vs[i].Name < vs[j].Name
+		}
+		if vs[i].A != vs[j].A {
+			return vs[i].A.X < vs[j].A.X || (vs[i].A.X == vs[j].A.X && vs[i].A.Y < vs[j].A.Y)
+		}
+		return vs[i].B.X < vs[j].B.X || (vs[i].B.X == vs[j].B.X && vs[i].B.Y < vs[j].B.Y)
+	})
+	return
+}
+
+// NetArea - return the minimal net area of cross-section with area A and
+// thickness thk for all failure paths through staggered holes for bolt b
+// in according to 6.2.2.2 EN1993-1-1:
+//
+//	Anet = A - n·d0·t + Σ s²·t/(4·p)
+//
+// where s is staggered pitch along load transfer and p is spacing of
+// the same holes perpendicular to load transfer.
+// Return the critical path of holes.
+func NetArea(A Area, thk Dimension, b Bolt, holes []Point, direction Direction) (Area, []Point) {
+	p := Pattern{Direction: direction}
+	sorted := append([]Point(nil), holes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		_, vi := p.uv(sorted[i])
+		_, vj := p.uv(sorted[j])
+		return vi < vj
+	})
+	do := float64(b.Do().Value())
+	t := float64(thk)
+
+	// maximal reduction of area for paths with the last hole i
+	reduction := make([]float64, len(sorted))
+	prev := make([]int, len(sorted))
+	best, last := 0.0, -1
+	for i := range sorted {
+		ui, vi := p.uv(sorted[i])
+		reduction[i], prev[i] = do*t, -1
+		for j := 0; j < i; j++ {
+			uj, vj := p.uv(sorted[j])
+			pv := float64(vi - vj)
+			if pv <= 0.0 {
+				continue
+			}
+			s := float64(ui - uj)
+			if r := reduction[j] + do*t - s*s*t/(4.0*pv); r > reduction[i] {
+				reduction[i], prev[i] = r, j
+			}
+		}
+		if reduction[i] > best {
+			best, last = reduction[i], i
+		}
+	}
+	var path []Point
+	for i := last; i >= 0; i = prev[i] {
+		path = append([]Point{sorted[i]}, path...)
+	}
+	return Area(float64(A) - best), path
+}
+
+// NetArea - return the minimal net area of plate and the critical path
+func (p Pattern) NetArea() (Area, []Point) {
+	_, vmin := p.uv(p.Min)
+	_, vmax := p.uv(p.Max)
+	A := Area(float64(vmax-vmin) * float64(p.Thk))
+	return NetArea(A, p.Thk, p.B, p.Holes, p.Direction)
+}
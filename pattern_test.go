@@ -0,0 +1,115 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExamplePattern() {
+	p := bolt.Pattern{
+		B: bolt.New(bolt.D20, bolt.G8p8),
+		Holes: []bolt.Point{
+			{X: 20e-3, Y: 40e-3},
+			{X: 60e-3, Y: 40e-3},
+			{X: 300e-3, Y: 40e-3},
+			{X: 80e-3, Y: 60e-3},
+			{X: 60e-3, Y: 120e-3},
+		},
+		Min:       bolt.Point{X: 0, Y: 0},
+		Max:       bolt.Point{X: 400e-3, Y: 260e-3},
+		Thk:       10e-3,
+		Direction: bolt.AlongX,
+		Exposure:  bolt.ExposedToWeather,
+	}
+	for _, v := range p.Validate() {
+		fmt.Fprintf(os.Stdout, "%s\n", v)
+	}
+
+	// Output:
+	// L = 28.3 mm is less 52.8 mm at (60.0 mm, 40.0 mm) and (80.0 mm, 60.0 mm)
+	// e1 = 20.0 mm is less 26.4 mm at (20.0 mm, 40.0 mm)
+	// e1 = 100.0 mm is more 80.0 mm at (300.0 mm, 40.0 mm)
+	// e2 = 140.0 mm is more 80.0 mm at (60.0 mm, 120.0 mm)
+	// p1 = 40.0 mm is less 48.4 mm at (20.0 mm, 40.0 mm) and (60.0 mm, 40.0 mm)
+	// p1 = 240.0 mm is more 140.0 mm at (60.0 mm, 40.0 mm) and (300.0 mm, 40.0 mm)
+	// p2 = 20.0 mm is less 26.4 mm at (60.0 mm, 40.0 mm) and (80.0 mm, 60.0 mm)
+}
+
+func TestPattern(t *testing.T) {
+	// valid pattern of 2 x 3 bolts
+	p := bolt.Pattern{
+		B:        bolt.New(bolt.D16, bolt.G8p8),
+		Min:      bolt.Point{X: 0, Y: 0},
+		Max:      bolt.Point{X: 200e-3, Y: 140e-3},
+		Thk:      10e-3,
+		Exposure: bolt.NotExposed,
+	}
+	for _, x := range []bolt.Dimension{40e-3, 100e-3, 160e-3} {
+		for _, y := range []bolt.Dimension{35e-3, 105e-3} {
+			p.Holes = append(p.Holes, bolt.Point{X: x, Y: y})
+		}
+	}
+	for _, dir := range []bolt.Direction{bolt.AlongX, bolt.AlongY} {
+		p.Direction = dir
+		if vs := p.Validate(); len(vs) != 0 {
+			t.Errorf("valid pattern have violations: %v", vs)
+		}
+	}
+	// staggered holes with L >= 2.4d0
+	p.Holes = []bolt.Point{{X: 40e-3, Y: 50e-3}, {X: 80e-3, Y: 80e-3}}
+	p.Direction = bolt.AlongX
+	if vs := p.Validate(); len(vs) != 0 {
+		t.Errorf("valid staggered pattern have violations: %v", vs)
+	}
+	p.Holes = []bolt.Point{{X: 40e-3, Y: 50e-3}, {X: 60e-3, Y: 70e-3}}
+	if vs := p.Validate(); len(vs) == 0 {
+		t.Errorf("not valid staggered pattern have not violations")
+	}
+}
+
+func ExamplePattern_NetArea() {
+	// staggered holes in plate 200 x 10 mm
+	p := bolt.Pattern{
+		B: bolt.New(bolt.D20, bolt.G8p8),
+		Holes: []bolt.Point{
+			{X: 50e-3, Y: 40e-3},
+			{X: 110e-3, Y: 100e-3},
+			{X: 50e-3, Y: 160e-3},
+		},
+		Min:       bolt.Point{X: 0, Y: 0},
+		Max:       bolt.Point{X: 300e-3, Y: 200e-3},
+		Thk:       10e-3,
+		Direction: bolt.AlongX,
+	}
+	anet, path := p.NetArea()
+	fmt.Fprintf(os.Stdout, "Anet = %s, path %v\n", anet, path)
+
+	// Output:
+	// Anet = 1560.0 mm², path [(50.0 mm, 40.0 mm) (50.0 mm, 160.0 mm)]
+}
+
+func TestNetArea(t *testing.T) {
+	b := bolt.New(bolt.D16, bolt.G8p8)
+	do := float64(b.Do().Value())
+	A := bolt.Area(150e-3 * 10e-3)
+	// holes in one cross-section
+	holes := []bolt.Point{{X: 0, Y: 40e-3}, {X: 0, Y: 110e-3}}
+	anet, path := bolt.NetArea(A, 10e-3, b, holes, bolt.AlongX)
+	if math.Abs(float64(anet)-(float64(A)-2*do*10e-3)) > 1e-9 || len(path) != 2 {
+		t.Errorf("not valid net area %s for path %v", anet, path)
+	}
+	// far staggered holes - critical path is through one hole
+	holes = []bolt.Point{{X: 0, Y: 40e-3}, {X: 500e-3, Y: 110e-3}}
+	anet, path = bolt.NetArea(A, 10e-3, b, holes, bolt.AlongX)
+	if math.Abs(float64(anet)-(float64(A)-do*10e-3)) > 1e-9 || len(path) != 1 {
+		t.Errorf("not valid net area %s for path %v", anet, path)
+	}
+	// without holes
+	if anet, path := bolt.NetArea(A, 10e-3, b, nil, bolt.AlongY); anet != A || len(path) != 0 {
+		t.Errorf("not valid net area %s for path %v", anet, path)
+	}
+}
@@ -9,7 +9,15 @@ import (
 type Force float64
 
 func (f Force) String() string {
-	return fmt.Sprintf("%.1f kN", float64(f)*1e-3)
+	return f.In(SI())
+}
+
+// Moment - type of bending moment.
+// unit: N*m
+type Moment float64
+
+func (m Moment) String() string {
+	return m.In(SI())
 }
 
 // Factor - type of factors
@@ -30,9 +38,6 @@ var ανThreadShear = map[Class]Factor{
 	G10p9: 0.5,
 }
 
-// ανThreadShear - factor if shear not by thread of bolt
-var ανUnthreadShear Factor = 0.6
-
 // PositionShear - position of shear on thread or not
 type PositionShear bool
 
@@ -49,19 +54,18 @@ func (pos PositionShear) String() string {
 	return "Shear plane passes through the unthreaded portion of the bolt"
 }
 
-// FactorγM2 - factor
-var FactorγM2 Factor = 1.25
-
 // ShearResistance - force of resistance on shear
 type ShearResistance struct {
 	B        Bolt
 	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	Units    Units         // units of report, SI units if empty
 }
 
 func (sr ShearResistance) αν() Factor {
 	switch sr.Position {
 	case UnthreadShear:
-		return ανUnthreadShear
+		return sr.NA.get().FactorανUnthread
 	}
 	//case ThreadShear:
 	return ανThreadShear[sr.B.bc]
@@ -69,17 +73,18 @@ func (sr ShearResistance) αν() Factor {
 
 // Value - return Force of shear resistance
 func (sr ShearResistance) Value() Force {
-	return Force(float64(sr.αν()) * float64(sr.B.Fub().Value()) * float64(sr.B.As().Value()) / float64(FactorγM2))
+	return Force(float64(sr.αν()) * float64(sr.B.Fub().Value()) * float64(sr.B.As().Value()) / float64(sr.NA.get().FactorγM2))
 }
 
 func (sr ShearResistance) String() (s string) {
-	s += fmt.Sprintf("Calculation of shear resistance for %s%s:\n", sr.B.bd, sr.B.bc)
-	s += fmt.Sprintf("\tγM2 = %s\n", FactorγM2)
-	s += fmt.Sprintf("\tαν  = %s - %s\n", sr.αν(), sr.Position)
-	s += fmt.Sprintf("\tFub = %s\n", sr.B.Fub().Value())
-	s += fmt.Sprintf("\tAs  = %s\n", sr.B.As().Value())
+	u := sr.Units.get()
+	s += u.Sprintf("Calculation of shear resistance for %s%s:\n", sr.B.bd, sr.B.bc)
+	s += u.Sprintf("\tγM2 = %s\n", sr.NA.get().FactorγM2)
+	s += u.Sprintf("\tαν  = %s - %s\n", sr.αν(), sr.Position)
+	s += u.Sprintf("\tFub = %s\n", sr.B.Fub().Value())
+	s += u.Sprintf("\tAs  = %s\n", sr.B.As().Value())
 	s += "\tIn according to table 3.4 EN1993-1-8:\n"
-	s += fmt.Sprintf("\tShear resistance is %s", sr.Value())
+	s += u.Sprintf("\tShear resistance is %s", sr.Value())
 	return
 }
 
@@ -101,31 +106,220 @@ func (bt Type) String() string {
 
 // TensionResistance - force of resistance on tension
 type TensionResistance struct {
-	B  Bolt
-	BT Type
+	B     Bolt
+	BT    Type
+	NA    NationalAnnex // national annex, recommended values if empty
+	Units Units         // units of report, SI units if empty
 }
 
 // K2 - Factor
 func (t TensionResistance) K2() Factor {
 	if t.BT { // == CountersunkBolt
-		return Factor(0.63)
+		return t.NA.get().FactorK2Countersunk
 	}
-	return 0.9
+	return t.NA.get().FactorK2
 }
 
 // Value - return Force of tension resistance
 func (t TensionResistance) Value() Force {
-	return Force(float64(t.K2()) * float64(t.B.Fub().Value()) * float64(t.B.As().Value()) / float64(FactorγM2))
+	return Force(float64(t.K2()) * float64(t.B.Fub().Value()) * float64(t.B.As().Value()) / float64(t.NA.get().FactorγM2))
 }
 
 func (t TensionResistance) String() (s string) {
-	s += fmt.Sprintf("Calculation of tension resistance for %s%s:\n", t.B.bd, t.B.bc)
-	s += fmt.Sprintf("\tγM2 = %s\n", FactorγM2)
-	s += fmt.Sprintf("\tk2  = %s - %s\n", t.K2(), t.BT)
-	s += fmt.Sprintf("\tFub = %s\n", t.B.Fub().Value())
-	s += fmt.Sprintf("\tAs  = %s\n", t.B.As().Value())
+	u := t.Units.get()
+	s += u.Sprintf("Calculation of tension resistance for %s%s:\n", t.B.bd, t.B.bc)
+	s += u.Sprintf("\tγM2 = %s\n", t.NA.get().FactorγM2)
+	s += u.Sprintf("\tk2  = %s - %s\n", t.K2(), t.BT)
+	s += u.Sprintf("\tFub = %s\n", t.B.Fub().Value())
+	s += u.Sprintf("\tAs  = %s\n", t.B.As().Value())
+	s += "\tIn according to table 3.4 EN1993-1-8:\n"
+	s += u.Sprintf("\tTension resistance is %s", t.Value())
+	return
+}
+
+// SlipResistance - force of slip resistance of preloaded bolt
+// in according to 3.9 EN1993-1-8
+type SlipResistance struct {
+	B              Bolt
+	Ks             Factor        // factor of hole, table 3.6 EN1993-1-8
+	N              int           // number of friction surfaces
+	Mu             Factor        // slip factor, table 3.7 EN1993-1-8
+	Serviceability bool          // slip resistance at serviceability limit state
+	NA             NationalAnnex // national annex, recommended values if empty
+	Units          Units         // units of report, SI units if empty
+}
+
+// γM3 - return partial factor for slip resistance
+func (sr SlipResistance) γM3() Factor {
+	if sr.Serviceability {
+		return sr.NA.get().FactorγM3ser
+	}
+	return sr.NA.get().FactorγM3
+}
+
+// FpC - return preload force in according to 3.9.1 EN1993-1-8.
+// Preload is zero for bolt classes other than 8.8 and 10.9.
+func (sr SlipResistance) FpC() Force {
+	if sr.B.bc != G8p8 && sr.B.bc != G10p9 {
+		return 0.0
+	}
+	return Force(0.7 * float64(sr.B.Fub().Value()) * float64(sr.B.As().Value()))
+}
+
+// FpCd - return design preload force in according to 3.6.1 EN1993-1-8
+func (sr SlipResistance) FpCd() Force {
+	return Force(float64(sr.FpC()) / float64(sr.NA.get().FactorγM7))
+}
+
+// Value - return Force of slip resistance for design tensile force
+// in according to 3.9.2 EN1993-1-8
+func (sr SlipResistance) Value(FtEd Force) Force {
+	fp := math.Max(float64(sr.FpC())-0.8*float64(FtEd), 0.0)
+	return Force(float64(sr.Ks) * float64(sr.N) * float64(sr.Mu) * fp / float64(sr.γM3()))
+}
+
+func (sr SlipResistance) String() (s string) {
+	u := sr.Units.get()
+	s += u.Sprintf("Calculation of slip resistance for %s%s:\n", sr.B.bd, sr.B.bc)
+	if sr.Serviceability {
+		s += u.Sprintf("\tγM3,ser = %s\n", sr.γM3())
+	} else {
+		s += u.Sprintf("\tγM3     = %s\n", sr.γM3())
+	}
+	s += u.Sprintf("\tγM7     = %s\n", sr.NA.get().FactorγM7)
+	s += u.Sprintf("\tks      = %s\n", sr.Ks)
+	s += u.Sprintf("\tn       = %d\n", sr.N)
+	s += u.Sprintf("\tμ       = %s\n", sr.Mu)
+	s += u.Sprintf("\tFp,C    = %s\n", sr.FpC())
+	s += u.Sprintf("\tFp,Cd   = %s - design preload\n", sr.FpCd())
+	s += "\tIn according to 3.9 EN1993-1-8:\n"
+	s += u.Sprintf("\tSlip resistance is %s", sr.Value(0.0))
+	return
+}
+
+// BearingResistance - force of bearing resistance of plate per bolt
+// in according to table 3.4 EN1993-1-8.
+// Distance equal zero is interpreted as not relevant, so the minimal
+// factors are found for all relevant end and inner bolts.
+// Steel takes precedence over Fu, resistance is zero for not valid strength.
+// Unit - meter, Pa
+type BearingResistance struct {
+	B     Bolt
+	Thk   Dimension     // thickness of plate
+	Steel Steel         // steel grade of plate
+	Fu    Stress        // ultimate tensile strength of plate
+	E1    Dimension     // end distance in direction of load transfer
+	P1    Dimension     // spacing in direction of load transfer
+	E2    Dimension     // edge distance perpendicular to load transfer
+	P2    Dimension     // spacing perpendicular to load transfer
+	NA    NationalAnnex // national annex, recommended values if empty
+	Units Units         // units of report, SI units if empty
+}
+
+// fu - return ultimate tensile strength of plate
+func (br BearingResistance) fu() (Stress, error) {
+	return br.Steel.ultimate(br.Thk, br.Fu)
+}
+
+// αb - factor in according to table 3.4 EN1993-1-8
+func (br BearingResistance) αb() Factor {
+	do := float64(br.B.Do().Value())
+	αd := 1.0
+	if br.E1 > 0.0 {
+		αd = math.Min(αd, float64(br.E1)/(3.0*do))
+	}
+	if br.P1 > 0.0 {
+		αd = math.Min(αd, float64(br.P1)/(3.0*do)-0.25)
+	}
+	fu, err := br.fu()
+	if err != nil {
+		return 0.0
+	}
+	return Factor(math.Min(αd, float64(br.B.Fub().Value())/float64(fu)))
+}
+
+// K1 - factor in according to table 3.4 EN1993-1-8
+func (br BearingResistance) K1() Factor {
+	do := float64(br.B.Do().Value())
+	k1 := 2.5
+	if br.E2 > 0.0 {
+		k1 = math.Min(k1, 2.8*float64(br.E2)/do-1.7)
+	}
+	if br.P2 > 0.0 {
+		k1 = math.Min(k1, 1.4*float64(br.P2)/do-1.7)
+	}
+	return Factor(k1)
+}
+
+// Value - return Force of bearing resistance
+func (br BearingResistance) Value() Force {
+	fu, err := br.fu()
+	if err != nil {
+		return 0.0
+	}
+	return Force(float64(br.K1()) * float64(br.αb()) * float64(fu) *
+		float64(br.B.D()) * float64(br.Thk) / float64(br.NA.get().FactorγM2))
+}
+
+func (br BearingResistance) String() (s string) {
+	u := br.Units.get()
+	s += u.Sprintf("Calculation of bearing resistance for %s%s:\n", br.B.bd, br.B.bc)
+	s += u.Sprintf("\tγM2 = %s\n", br.NA.get().FactorγM2)
+	s += u.Sprintf("\tk1  = %s\n", br.K1())
+	s += u.Sprintf("\tαb  = %s\n", br.αb())
+	if fu, err := br.fu(); err != nil {
+		s += u.Sprintf("\tError: %v\n", err)
+	} else {
+		s += u.Sprintf("\tFu  = %s\n", fu)
+	}
+	s += u.Sprintf("\tt   = %s\n", br.Thk)
 	s += "\tIn according to table 3.4 EN1993-1-8:\n"
-	s += fmt.Sprintf("\tTension resistance is %s", t.Value())
+	s += u.Sprintf("\tBearing resistance is %s", br.Value())
+	return
+}
+
+// BlockTearing - force of block tearing resistance of bolt group
+// in according to 3.10.2 EN1993-1-8.
+// Steel takes precedence over Fy, Fu, resistance is zero for not valid strength.
+// Unit - sq.meter, Pa
+type BlockTearing struct {
+	Ant       Area          // net area subjected to tension
+	Anv       Area          // net area subjected to shear
+	Thk       Dimension     // thickness of plate, used only for steel grade
+	Steel     Steel         // steel grade of plate
+	Fy        Stress        // yield strength of plate
+	Fu        Stress        // ultimate tensile strength of plate
+	Eccentric bool          // true for eccentric loading of bolt group
+	NA        NationalAnnex // national annex, recommended values if empty
+	Units     Units         // units of report, SI units if empty
+}
+
+// Value - return Force of block tearing resistance
+func (bt BlockTearing) Value() Force {
+	kt := 1.0
+	if bt.Eccentric {
+		kt = 0.5
+	}
+	fy, fu, err := bt.Steel.strength(bt.Thk, bt.Fy, bt.Fu)
+	if err != nil {
+		return 0.0
+	}
+	return Force(kt*float64(fu)*float64(bt.Ant)/float64(bt.NA.get().FactorγM2) +
+		float64(fy)*float64(bt.Anv)/(math.Sqrt(3.0)*float64(bt.NA.get().FactorγM0)))
+}
+
+func (bt BlockTearing) String() (s string) {
+	u := bt.Units.get()
+	s += "Calculation of block tearing resistance:\n"
+	s += u.Sprintf("\tγM0 = %s\n", bt.NA.get().FactorγM0)
+	s += u.Sprintf("\tγM2 = %s\n", bt.NA.get().FactorγM2)
+	s += u.Sprintf("\tAnt = %s\n", bt.Ant)
+	s += u.Sprintf("\tAnv = %s\n", bt.Anv)
+	if _, _, err := bt.Steel.strength(bt.Thk, bt.Fy, bt.Fu); err != nil {
+		s += u.Sprintf("\tError: %v\n", err)
+	}
+	s += "\tIn according to 3.10.2 EN1993-1-8:\n"
+	s += u.Sprintf("\tBlock tearing resistance is %s", bt.Value())
 	return
 }
 
@@ -134,6 +328,8 @@ type Resistance struct {
 	B        Bolt
 	BT       Type
 	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	Units    Units         // units of report, SI units if empty
 }
 
 // ViewResult - type of result view
@@ -145,29 +341,32 @@ const (
 	FullView
 )
 
-// Value - return result of combined resistance calculation
+// Value - return result of combined resistance calculation.
+// Bolt class without strength in table 3.1 EN1993-1-8 is failed with
+// infinity factor.
 func (r Resistance) Value(FvEd, FtEd Force, view ViewResult) (_ Factor, s string) {
+	u := r.Units.get()
 	max := 0.0
 
-	FvRd := ShearResistance{B: r.B, Position: r.Position}
-	f1 := float64(FvEd) / float64(FvRd.Value())
+	FvRd := ShearResistance{B: r.B, Position: r.Position, NA: r.NA, Units: r.Units}
+	f1 := ratio(FvEd, FvRd.Value())
 	if view == FullView {
-		s += fmt.Sprintf("%s\n", FvRd)
-		s += fmt.Sprintf("Factor %s\n", Factor(f1))
+		s += u.Sprintf("%s\n", FvRd)
+		s += u.Sprintf("Factor %s\n", Factor(f1))
 	}
 	max = math.Max(max, f1)
 
-	FtRd := TensionResistance{B: r.B, BT: r.BT}
-	f2 := float64(FtEd) / float64(FtRd.Value())
+	FtRd := TensionResistance{B: r.B, BT: r.BT, NA: r.NA, Units: r.Units}
+	f2 := ratio(FtEd, FtRd.Value())
 	if view == FullView {
-		s += fmt.Sprintf("%s\n", FtRd)
-		s += fmt.Sprintf("Factor %s\n", Factor(f2))
+		s += u.Sprintf("%s\n", FtRd)
+		s += u.Sprintf("Factor %s\n", Factor(f2))
 	}
 	max = math.Max(max, f2)
 
-	max = math.Max(max, float64(FvEd)/float64(FvRd.Value())+float64(FtEd)/(1.4*float64(FtRd.Value())))
+	max = math.Max(max, ratio(FvEd, FvRd.Value())+ratio(FtEd, 1.4*FtRd.Value()))
 	if view == FullView {
-		s += fmt.Sprintf("Summary factor of combined loads is %s\n", Factor(max))
+		s += u.Sprintf("Summary factor of combined loads is %s\n", Factor(max))
 	}
 
 	return Factor(max), s
@@ -42,6 +42,24 @@ func ExampleTensionResistance() {
 	// 	Tension resistance is 127.0 kN
 }
 
+func ExampleSlipResistance() {
+	b := bolt.New(bolt.D20, bolt.G10p9)
+	sr := bolt.SlipResistance{B: b, Ks: 1.0, N: 2, Mu: 0.5}
+	fmt.Fprintf(os.Stdout, "%s\n", sr)
+
+	// Output:
+	// Calculation of slip resistance for HM20Cl10.9:
+	// 	γM3     = 1.250
+	// 	γM7     = 1.100
+	// 	ks      = 1.000
+	// 	n       = 2
+	// 	μ       = 0.500
+	// 	Fp,C    = 171.5 kN
+	// 	Fp,Cd   = 155.9 kN - design preload
+	// 	In according to 3.9 EN1993-1-8:
+	// 	Slip resistance is 137.2 kN
+}
+
 func ExampleAddClass() {
 	class := bolt.Class("S245")
 	bolt.AddClass(
@@ -263,3 +281,46 @@ func TestResistance(t *testing.T) {
 		t.Errorf("Factor can not be less 1.0 if load is huge")
 	}
 }
+
+func ExampleBearingResistance() {
+	b := bolt.New(bolt.D20, bolt.G8p8)
+	br := bolt.BearingResistance{
+		B:   b,
+		Thk: 10e-3,
+		Fu:  410e6,
+		E1:  40e-3,
+		P1:  70e-3,
+		E2:  50e-3,
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", br)
+
+	// Output:
+	// Calculation of bearing resistance for HM20Cl8.8:
+	// 	γM2 = 1.250
+	// 	k1  = 2.500
+	// 	αb  = 0.606
+	// 	Fu  = 410.0 MPa
+	// 	t   = 10.0 mm
+	// 	In according to table 3.4 EN1993-1-8:
+	// 	Bearing resistance is 99.4 kN
+}
+
+func ExampleBlockTearing() {
+	bt := bolt.BlockTearing{
+		Ant:       bolt.Area(10e-3 * 39e-3),
+		Anv:       bolt.Area(10e-3 * 171e-3),
+		Fy:        275e6,
+		Fu:        410e6,
+		Eccentric: true,
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", bt)
+
+	// Output:
+	// Calculation of block tearing resistance:
+	// 	γM0 = 1.000
+	// 	γM2 = 1.250
+	// 	Ant = 390.0 mm²
+	// 	Anv = 1710.0 mm²
+	// 	In according to 3.10.2 EN1993-1-8:
+	// 	Block tearing resistance is 335.5 kN
+}
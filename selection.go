@@ -0,0 +1,123 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Rank - criteria of ranking of bolt candidates
+type Rank bool
+
+// Constants of ranking
+const (
+	ByWeight Rank = false
+	ByCost        = true
+)
+
+func (r Rank) String() string {
+	if r { // == ByCost
+		return "ranked by cost"
+	}
+	return "ranked by weight"
+}
+
+// Constraints - constraints of bolt selection.
+// Zero values are interpreted as not limited.
+type Constraints struct {
+	Classes     []Class           // allowable classes, all classes if empty
+	MaxDiameter Diameter          // maximal diameter of bolt
+	MaxHole     DiameterDimension // maximal diameter of hole
+	Thk         Dimension         // thickness of plate, bearing is not checked if zero
+	Steel       Steel             // steel grade of plate, Fu is used if empty
+	Fu          Stress            // ultimate tensile strength of plate, required if thickness is not zero and steel grade is empty
+	Prices      map[Class]float64 // price of bolts per kg for each class, required for ranking by cost
+	NA          NationalAnnex     // national annex, recommended values if empty
+}
+
+// Candidate - bolt of selection with utilisation
+type Candidate struct {
+	B      Bolt
+	Factor Factor  // utilisation of bolt
+	Weight Mass    // mass of bolt shank per unit length, kg/m
+	Cost   float64 // cost of bolt per unit length by price of class, zero if price is not declared
+}
+
+func (c Candidate) String() string {
+	return fmt.Sprintf("%s: factor %s, weight %.2f kg/m, cost %.2f", c.B, c.Factor, float64(c.Weight), c.Cost)
+}
+
+// Select - return bolts with utilisation not more 1.0 for design shear
+// and tension forces ranked by weight or cost. Utilisation is found by
+// Resistance and by bearing resistance of plate with minimal distances
+// in according to table 3.3 EN1993-1-8. Cost is weight multiplied by
+// price of bolt class.
+func Select(FvEd, FtEd Force, position PositionShear, bt Type, c Constraints, rank Rank) (cs []Candidate, err error) {
+	classes := c.Classes
+	if len(classes) == 0 {
+		classes = GetBoltClassList()
+	}
+	if 0.0 < c.Thk {
+		if _, err := c.Steel.ultimate(c.Thk, c.Fu); err != nil {
+			return nil, err
+		}
+	}
+	for _, bc := range classes {
+		if _, ok := fub[bc]; !ok {
+			return nil, fmt.Errorf("bolt class %s is not found in table 3.1 EN1993-1-8", bc)
+		}
+		if price := c.Prices[bc]; rank == ByCost && price <= 0.0 {
+			return nil, fmt.Errorf("price of bolt class %s is not declared for ranking by cost", bc)
+		}
+	}
+	for _, bd := range GetBoltDiameterList() {
+		if 0.0 < c.MaxDiameter && c.MaxDiameter < bd {
+			continue
+		}
+		if hd := (HoleDiameter{Dia: bd}).Value(); 0.0 < c.MaxHole && c.MaxHole < hd {
+			continue
+		}
+		for _, bc := range classes {
+			b := New(bd, bc)
+			factor, _ := Resistance{B: b, BT: bt, Position: position, NA: c.NA}.Value(FvEd, FtEd, NoView)
+			if 0.0 < c.Thk {
+				d := GetDistances(b, c.Thk, ExposedToWeather)
+				br := BearingResistance{
+					B:     b,
+					Thk:   c.Thk,
+					Steel: c.Steel,
+					Fu:    c.Fu,
+					E1:    d.E1min(),
+					P1:    d.P1min(),
+					E2:    d.E2min(),
+					P2:    d.P2min(),
+					NA:    c.NA,
+				}
+				factor = Factor(math.Max(float64(factor), ratio(FvEd, br.Value())))
+			}
+			if 1.0 < factor {
+				continue
+			}
+			w := Mass(float64(b.A().Value()) * densitySteel)
+			cs = append(cs, Candidate{
+				B:      b,
+				Factor: factor,
+				Weight: w,
+				Cost:   float64(w) * c.Prices[bc],
+			})
+		}
+	}
+	sort.SliceStable(cs, func(i, j int) bool {
+		if rank == ByCost && cs[i].Cost != cs[j].Cost {
+			return cs[i].Cost < cs[j].Cost
+		}
+		if cs[i].Weight != cs[j].Weight {
+			return cs[i].Weight < cs[j].Weight
+		}
+		if cs[i].Cost != cs[j].Cost {
+			return cs[i].Cost < cs[j].Cost
+		}
+		return cs[i].Factor > cs[j].Factor
+	})
+	return
+}
@@ -0,0 +1,106 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleSelect() {
+	c := bolt.Constraints{
+		Classes:     []bolt.Class{bolt.G5p6, bolt.G8p8, bolt.G10p9},
+		MaxDiameter: bolt.D30,
+		Thk:         12e-3,
+		Fu:          360e6,
+		Prices: map[bolt.Class]float64{
+			bolt.G5p6:  2.5,
+			bolt.G8p8:  3.2,
+			bolt.G10p9: 4.0,
+		},
+	}
+	for _, rank := range []bolt.Rank{bolt.ByWeight, bolt.ByCost} {
+		fmt.Fprintf(os.Stdout, "Candidates %s:\n", rank)
+		cs, err := bolt.Select(40e3, 20e3, bolt.ThreadShear, bolt.UsuallyBolt, c, rank)
+		if err != nil {
+			panic(err)
+		}
+		for _, cand := range cs {
+			fmt.Fprintf(os.Stdout, "%s\n", cand)
+		}
+	}
+
+	// Output:
+	// Candidates ranked by weight:
+	// HM20Cl5.6: factor 0.872, weight 2.47 kg/m, cost 6.17
+	// HM20Cl8.8: factor 0.872, weight 2.47 kg/m, cost 7.89
+	// HM20Cl10.9: factor 0.872, weight 2.47 kg/m, cost 9.86
+	// HM24Cl5.6: factor 0.726, weight 3.55 kg/m, cost 8.88
+	// HM24Cl8.8: factor 0.726, weight 3.55 kg/m, cost 11.36
+	// HM24Cl10.9: factor 0.726, weight 3.55 kg/m, cost 14.21
+	// HM30Cl5.6: factor 0.581, weight 5.55 kg/m, cost 13.87
+	// HM30Cl8.8: factor 0.581, weight 5.55 kg/m, cost 17.76
+	// HM30Cl10.9: factor 0.581, weight 5.55 kg/m, cost 22.20
+	// Candidates ranked by cost:
+	// HM20Cl5.6: factor 0.872, weight 2.47 kg/m, cost 6.17
+	// HM20Cl8.8: factor 0.872, weight 2.47 kg/m, cost 7.89
+	// HM24Cl5.6: factor 0.726, weight 3.55 kg/m, cost 8.88
+	// HM20Cl10.9: factor 0.872, weight 2.47 kg/m, cost 9.86
+	// HM24Cl8.8: factor 0.726, weight 3.55 kg/m, cost 11.36
+	// HM30Cl5.6: factor 0.581, weight 5.55 kg/m, cost 13.87
+	// HM24Cl10.9: factor 0.726, weight 3.55 kg/m, cost 14.21
+	// HM30Cl8.8: factor 0.581, weight 5.55 kg/m, cost 17.76
+	// HM30Cl10.9: factor 0.581, weight 5.55 kg/m, cost 22.20
+}
+
+func TestSelect(t *testing.T) {
+	c := bolt.Constraints{MaxHole: 22e-3}
+	cs, err := bolt.Select(50e3, 0, bolt.UnthreadShear, bolt.UsuallyBolt, c, bolt.ByWeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) == 0 {
+		t.Fatalf("candidates are not found")
+	}
+	for _, cand := range cs {
+		if cand.B.Do().Value() > 22e-3 {
+			t.Errorf("hole of %s is more limit", cand.B)
+		}
+		f, _ := bolt.Resistance{B: cand.B, Position: bolt.UnthreadShear}.Value(50e3, 0, bolt.NoView)
+		if f != cand.Factor || f > 1.0 {
+			t.Errorf("not valid factor of %s", cand)
+		}
+	}
+	for i := 1; i < len(cs); i++ {
+		if cs[i-1].Weight > cs[i].Weight {
+			t.Errorf("candidates are not sorted by weight")
+		}
+	}
+	c.Prices = map[bolt.Class]float64{}
+	for _, bc := range bolt.GetBoltClassList() {
+		c.Prices[bc] = 1.0
+	}
+	if cs, err := bolt.Select(1e9, 0, bolt.ThreadShear, bolt.UsuallyBolt, c, bolt.ByCost); err != nil || len(cs) != 0 {
+		t.Errorf("candidates for huge load: %v", cs)
+	}
+}
+
+func TestSelectConstraints(t *testing.T) {
+	for _, c := range []struct {
+		c    bolt.Constraints
+		rank bolt.Rank
+	}{
+		{c: bolt.Constraints{Thk: 3e-3}, rank: bolt.ByWeight},
+		{c: bolt.Constraints{Classes: []bolt.Class{bolt.G6p6}}, rank: bolt.ByWeight},
+		{c: bolt.Constraints{Classes: []bolt.Class{bolt.G8p8}}, rank: bolt.ByCost},
+		{c: bolt.Constraints{
+			Classes: []bolt.Class{bolt.G8p8, bolt.G10p9},
+			Prices:  map[bolt.Class]float64{bolt.G8p8: 3.0},
+		}, rank: bolt.ByCost},
+	} {
+		if _, err := bolt.Select(10e3, 0, bolt.ThreadShear, bolt.UsuallyBolt, c.c, c.rank); err == nil {
+			t.Errorf("not valid constraints are accepted: %#v", c.c)
+		}
+	}
+}
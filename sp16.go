@@ -0,0 +1,307 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// G6p6 - bolt class in according to GOST 1759.4
+const G6p6 Class = "6.6"
+
+// GetSP16ClassList - list of bolt classes in according to table G.5
+// SP 16.13330.2017
+func GetSP16ClassList() []Class {
+	return []Class{G4p6, G4p8, G5p6, G5p8, G6p6, G8p8, G10p9}
+}
+
+// Table of design strength of bolts in shear Rbs, in tension Rbt and
+// ultimate strength Rbun in according to table G.5 SP 16.13330.2017.
+// unit: Pa
+var (
+	sp16Rbs = map[Class]Stress{
+		G4p6:  150.e6,
+		G4p8:  160.e6,
+		G5p6:  190.e6,
+		G5p8:  200.e6,
+		G6p6:  230.e6,
+		G8p8:  320.e6,
+		G10p9: 400.e6,
+	}
+	sp16Rbt = map[Class]Stress{
+		G4p6:  170.e6,
+		G4p8:  160.e6,
+		G5p6:  210.e6,
+		G5p8:  200.e6,
+		G6p6:  250.e6,
+		G8p8:  430.e6,
+		G10p9: 500.e6,
+	}
+)
+
+// Table of ultimate strength Rbun of high-strength bolts made of
+// steel 40X "select" in according to table G.8 SP 16.13330.2017.
+// Bolts M12 are not tabulated.
+// unit: Pa
+var sp16Rbun = map[Diameter]Stress{
+	D16: 1100.e6,
+	D20: 1100.e6,
+	D24: 1100.e6,
+	D30: 950.e6,
+	D36: 750.e6,
+	D42: 650.e6,
+	D48: 600.e6,
+}
+
+// elasticModulus - modulus of elasticity of steel.
+// unit: Pa
+const elasticModulus Stress = 2.06e11
+
+// SP16 - bolt of bearing connection in according to 14.2 SP 16.13330.2017
+type SP16 struct {
+	B        Bolt
+	Ns       int    // amount of shear planes
+	N        int    // amount of bolts in connection
+	FactorγC Factor // service condition factor, table 1 SP 16.13330.2017
+
+	// Connected elements
+	Thk   Dimension // minimal total thickness of elements bearing in one direction
+	Run   Stress    // ultimate strength of connected steel
+	A     Dimension // distance from bolt center to edge along force
+	S     Dimension // spacing of bolts along force
+	Units Units     // units of report, SI units if empty
+}
+
+// Rbs - design strength of bolt in shear
+func (sp SP16) Rbs() Stress {
+	return sp16Rbs[sp.B.bc]
+}
+
+// Rbt - design strength of bolt in tension
+func (sp SP16) Rbt() Stress {
+	return sp16Rbt[sp.B.bc]
+}
+
+// Rbp - design strength of connected steel in bearing for bolts of
+// accuracy class B in according to table G.6 SP 16.13330.2017
+func (sp SP16) Rbp() Stress {
+	run := float64(sp.Run)
+	return Stress((0.6 + 340.0*run/float64(elasticModulus)) * run)
+}
+
+// FactorγbShear - factor of connection in shear in according to
+// table 41 SP 16.13330.2017
+func (sp SP16) FactorγbShear() Factor {
+	if 1 < sp.N {
+		return 0.9
+	}
+	return 1.0
+}
+
+// FactorγbBearing - factor of connection in bearing in according to
+// table 41 SP 16.13330.2017. Values for ultimate strength of connected
+// steel Run above 380 MPa are taken as for range from 285 to 380 MPa.
+func (sp SP16) FactorγbBearing() Factor {
+	d := float64(sp.B.D())
+	if sp.Run <= 285.e6 {
+		γb := math.Min(1.0, 0.4*float64(sp.A)/d+0.2)
+		if 1 < sp.N {
+			γb = math.Min(γb, 0.4*float64(sp.S)/d)
+		}
+		return Factor(γb)
+	}
+	γb := math.Min(1.0, 0.5*float64(sp.A)/d)
+	if 1 < sp.N {
+		γb = math.Min(γb, 0.5*float64(sp.S)/d-0.25)
+	}
+	return Factor(γb)
+}
+
+// γc - service condition factor, default value is 1.0
+func (sp SP16) γc() float64 {
+	if sp.FactorγC == 0.0 {
+		return 1.0
+	}
+	return float64(sp.FactorγC)
+}
+
+// Nbs - design shear resistance of bolt in according to 14.2.9
+// SP 16.13330.2017
+func (sp SP16) Nbs() Force {
+	return Force(float64(sp.Rbs()) * float64(sp.B.A().Value()) * float64(sp.Ns) *
+		float64(sp.FactorγbShear()) * sp.γc())
+}
+
+// Nbp - design bearing resistance of bolt in according to 14.2.9
+// SP 16.13330.2017
+func (sp SP16) Nbp() Force {
+	return Force(float64(sp.Rbp()) * float64(sp.B.D()) * float64(sp.Thk) *
+		float64(sp.FactorγbBearing()) * sp.γc())
+}
+
+// Nbt - design tension resistance of bolt in according to 14.2.9
+// SP 16.13330.2017
+func (sp SP16) Nbt() Force {
+	return Force(float64(sp.Rbt()) * float64(sp.B.As().Value()) * sp.γc())
+}
+
+// Value - return result of bolt calculation on shear force FvEd and
+// tension force FtEd per bolt in according to 14.2 SP 16.13330.2017
+func (sp SP16) Value(FvEd, FtEd Force, view ViewResult) (_ Factor, s string) {
+	u := sp.Units.get()
+	if view == FullView {
+		s += u.Sprintf("Calculation of bolt %s in according to SP 16.13330.2017:\n", sp.B)
+		s += u.Sprintf("\tγc  = %s\n", Factor(sp.γc()))
+		s += u.Sprintf("\tRbs = %s\n", sp.Rbs())
+		s += u.Sprintf("\tRbt = %s\n", sp.Rbt())
+	}
+	max := 0.0
+
+	f1 := ratio(FvEd, sp.Nbs())
+	if view == FullView {
+		s += u.Sprintf("\tγb  = %s\n", sp.FactorγbShear())
+		s += u.Sprintf("\tShear resistance is %s, factor %s\n", sp.Nbs(), Factor(f1))
+	}
+	max = math.Max(max, f1)
+
+	if 0.0 < sp.Thk {
+		f := ratio(FvEd, sp.Nbp())
+		if view == FullView {
+			s += u.Sprintf("\tRbp = %s\n", sp.Rbp())
+			s += u.Sprintf("\tγb  = %s\n", sp.FactorγbBearing())
+			s += u.Sprintf("\tBearing resistance is %s, factor %s\n", sp.Nbp(), Factor(f))
+		}
+		max = math.Max(max, f)
+	}
+
+	f2 := ratio(FtEd, sp.Nbt())
+	if view == FullView {
+		s += u.Sprintf("\tTension resistance is %s, factor %s\n", sp.Nbt(), Factor(f2))
+	}
+	max = math.Max(max, f2)
+
+	max = math.Max(max, math.Hypot(f1, f2))
+	if view == FullView {
+		s += u.Sprintf("Summary factor of combined loads is %s\n", Factor(max))
+	}
+	return Factor(max), s
+}
+
+// SP16Friction - high-strength bolt of friction connection in according
+// to 14.3 SP 16.13330.2017. Bolts are made of steel 40X "select".
+type SP16Friction struct {
+	D        Diameter
+	K        int    // amount of friction planes
+	N        int    // amount of bolts in connection
+	Mu       Factor // friction coefficient, table 42 SP 16.13330.2017
+	FactorγH Factor // reliability factor, table 42 SP 16.13330.2017
+	FactorγC Factor // service condition factor, table 1 SP 16.13330.2017
+	Units    Units  // units of report, SI units if empty
+}
+
+// Validate - return error if friction connection is not valid for
+// calculation
+func (sf SP16Friction) Validate() error {
+	if _, ok := sp16Rbun[sf.D]; !ok {
+		return fmt.Errorf("ultimate strength of high-strength bolt %s is not found in table G.8 SP 16.13330.2017", sf.D)
+	}
+	if sf.FactorγH <= 0.0 {
+		return fmt.Errorf("reliability factor γh is not declared")
+	}
+	return nil
+}
+
+// Rbh - design strength of high-strength bolt in tension
+// in according to 6.7 SP 16.13330.2017
+func (sf SP16Friction) Rbh() Stress {
+	return Stress(0.7 * float64(sp16Rbun[sf.D]))
+}
+
+// P - tension force of high-strength bolt
+func (sf SP16Friction) P() Force {
+	return Force(float64(sf.Rbh()) * float64(AreaAs{Dia: sf.D}.Value()))
+}
+
+// FactorγB - factor of friction connection depending on amount of bolts
+// in according to 14.3.3 SP 16.13330.2017
+func (sf SP16Friction) FactorγB() Factor {
+	switch {
+	case sf.N < 5:
+		return 0.8
+	case sf.N < 10:
+		return 0.9
+	}
+	return 1.0
+}
+
+// Qbh - design resistance of one friction plane of bolt
+// in according to 14.3.3 SP 16.13330.2017
+func (sf SP16Friction) Qbh() Force {
+	return Force(float64(sf.P()) * float64(sf.Mu) / float64(sf.FactorγH))
+}
+
+// Value - return result of bolt calculation on shear force FvEd and
+// tension force FtEd per bolt in according to 14.3 SP 16.13330.2017.
+// Not valid friction connection is failed with infinity factor.
+func (sf SP16Friction) Value(FvEd, FtEd Force, view ViewResult) (_ Factor, s string) {
+	u := sf.Units.get()
+	if err := sf.Validate(); err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of high-strength bolt %s is not valid: %v\n", sf.D, err)
+	}
+	γc := float64(sf.FactorγC)
+	if γc == 0.0 {
+		γc = 1.0
+	}
+	rd := float64(sf.Qbh()) * float64(sf.K) * float64(sf.FactorγB()) * γc *
+		math.Max(0.0, 1.0-float64(FtEd)/float64(sf.P()))
+	f := ratio(FvEd, Force(rd))
+	if view == FullView {
+		s += u.Sprintf("Calculation of high-strength bolt %s in according to SP 16.13330.2017:\n", sf.D)
+		s += u.Sprintf("\tγc  = %s\n", Factor(γc))
+		s += u.Sprintf("\tγb  = %s\n", sf.FactorγB())
+		s += u.Sprintf("\tγh  = %s\n", sf.FactorγH)
+		s += u.Sprintf("\tμ   = %s\n", sf.Mu)
+		s += u.Sprintf("\tRbh = %s\n", sf.Rbh())
+		s += u.Sprintf("\tP   = %s\n", sf.P())
+		s += u.Sprintf("\tQbh = %s\n", sf.Qbh())
+		s += u.Sprintf("\tFriction resistance is %s, factor %s\n", Force(rd), Factor(f))
+	}
+	return Factor(f), s
+}
+
+// Calculator - bolt calculation on shear force FvEd and tension force
+// FtEd per bolt
+type Calculator interface {
+	Value(FvEd, FtEd Force, view ViewResult) (Factor, string)
+}
+
+// Code - design code of bolt calculation
+type Code int
+
+// Constants
+const (
+	CodeEN1993 Code = iota // EN1993-1-8
+	CodeSP16               // SP 16.13330.2017
+)
+
+func (c Code) String() string {
+	if c == CodeSP16 {
+		return "SP 16.13330.2017"
+	}
+	return "EN1993-1-8"
+}
+
+// NewCalculator - return bolt calculator of bearing connection with one
+// shear plane in according to design code. Error is returned if bolt
+// class is not present in tables of design code.
+func NewCalculator(code Code, b Bolt, bt Type, pos PositionShear) (Calculator, error) {
+	if code == CodeSP16 {
+		if _, ok := sp16Rbs[b.bc]; !ok {
+			return nil, fmt.Errorf("bolt class %s is not found in table G.5 %s", b.bc, code)
+		}
+		return SP16{B: b, Ns: 1, N: 1}, nil
+	}
+	if _, ok := fub[b.bc]; !ok {
+		return nil, fmt.Errorf("bolt class %s is not found in table 3.1 %s", b.bc, code)
+	}
+	return Resistance{B: b, BT: bt, Position: pos}, nil
+}
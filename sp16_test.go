@@ -0,0 +1,157 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleSP16() {
+	sp := bolt.SP16{
+		B:   bolt.New(bolt.D20, bolt.G5p6),
+		Ns:  1,
+		N:   4,
+		Thk: 10e-3,
+		Run: 370e6,
+		A:   40e-3,
+		S:   60e-3,
+	}
+	_, s := sp.Value(30e3, 10e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of bolt HM20Cl5.6 in according to SP 16.13330.2017:
+	// 	γc  = 1.000
+	// 	Rbs = 190.0 MPa
+	// 	Rbt = 210.0 MPa
+	// 	γb  = 0.900
+	// 	Shear resistance is 53.7 kN, factor 0.558
+	// 	Rbp = 448.0 MPa
+	// 	γb  = 1.000
+	// 	Bearing resistance is 89.6 kN, factor 0.335
+	// 	Tension resistance is 51.4 kN, factor 0.194
+	// Summary factor of combined loads is 0.591
+}
+
+func ExampleSP16Friction() {
+	sf := bolt.SP16Friction{
+		D:        bolt.D24,
+		K:        2,
+		N:        6,
+		Mu:       0.42,
+		FactorγH: 1.12,
+	}
+	_, s := sf.Value(150e3, 0.0, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of high-strength bolt HM24 in according to SP 16.13330.2017:
+	// 	γc  = 1.000
+	// 	γb  = 0.900
+	// 	γh  = 1.120
+	// 	μ   = 0.420
+	// 	Rbh = 770.0 MPa
+	// 	P   = 271.7 kN
+	// 	Qbh = 101.9 kN
+	// 	Friction resistance is 183.4 kN, factor 0.818
+}
+
+func TestSP16(t *testing.T) {
+	for _, bc := range bolt.GetSP16ClassList() {
+		for _, bd := range bolt.GetBoltDiameterList() {
+			sp := bolt.SP16{B: bolt.New(bd, bc), Ns: 1, N: 1}
+			if sp.Nbs() <= 0.0 || sp.Nbt() <= 0.0 {
+				t.Errorf("Not valid resistance of bolt %s", sp.B)
+			}
+		}
+	}
+	b := bolt.New(bolt.D24, bolt.G8p8)
+	for _, code := range []bolt.Code{bolt.CodeEN1993, bolt.CodeSP16} {
+		c, err := bolt.NewCalculator(code, b, bolt.UsuallyBolt, bolt.ThreadShear)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, _ := c.Value(0.0, 0.0, bolt.NoView); float64(f) != 0.0 {
+			t.Errorf("%s: factor can not be not zero if load is zero", code)
+		}
+		if f, _ := c.Value(1e10, 1e10, bolt.NoView); float64(f) < 1.0 {
+			t.Errorf("%s: factor can not be less 1.0 if load is huge", code)
+		}
+	}
+}
+
+func TestSP16Calculator(t *testing.T) {
+	b := bolt.New(bolt.D20, bolt.G6p6)
+	if _, err := bolt.NewCalculator(bolt.CodeEN1993, b, bolt.UsuallyBolt, bolt.ThreadShear); err == nil {
+		t.Errorf("bolt class %s is not in EN1993-1-8", bolt.G6p6)
+	}
+	if _, err := bolt.NewCalculator(bolt.CodeSP16, b, bolt.UsuallyBolt, bolt.ThreadShear); err != nil {
+		t.Error(err)
+	}
+	// bolt class without strength in table 3.1 EN1993-1-8
+	r := bolt.Resistance{B: b}
+	if f, _ := r.Value(10e3, 10e3, bolt.NoView); !math.IsInf(float64(f), 1) {
+		t.Errorf("bolt class %s is passed in EN1993-1-8: %v", bolt.G6p6, f)
+	}
+	if f, _ := r.Value(0.0, 0.0, bolt.NoView); f != 0.0 {
+		t.Errorf("factor of bolt class %s without load is %v", bolt.G6p6, f)
+	}
+	c := bolt.Constraints{Classes: []bolt.Class{bolt.G6p6}}
+	if _, err := bolt.Select(10e3, 0.0, bolt.ThreadShear, bolt.UsuallyBolt, c, bolt.ByWeight); err == nil {
+		t.Errorf("bolt class %s is selected in EN1993-1-8", bolt.G6p6)
+	}
+}
+
+func TestSP16FactorγbBearing(t *testing.T) {
+	tcs := []struct {
+		run  bolt.Stress
+		a, s bolt.Dimension
+		γb   float64
+	}{
+		{run: 255e6, a: 30e-3, s: 40e-3, γb: 0.8},
+		{run: 255e6, a: 50e-3, s: 60e-3, γb: 1.0},
+		{run: 370e6, a: 30e-3, s: 60e-3, γb: 0.75},
+		{run: 370e6, a: 40e-3, s: 45e-3, γb: 0.875},
+		{run: 370e6, a: 40e-3, s: 50e-3, γb: 1.0},
+	}
+	for _, tc := range tcs {
+		sp := bolt.SP16{B: bolt.New(bolt.D20, bolt.G5p6), N: 2, Run: tc.run, A: tc.a, S: tc.s}
+		if γb := float64(sp.FactorγbBearing()); math.Abs(γb-tc.γb) > 1e-9 {
+			t.Errorf("Run = %s, a = %s, s = %s: γb = %.3f, expected %.3f",
+				tc.run, tc.a, tc.s, γb, tc.γb)
+		}
+	}
+}
+
+func TestSP16FrictionValidate(t *testing.T) {
+	for _, bd := range bolt.GetBoltDiameterList() {
+		sf := bolt.SP16Friction{D: bd, K: 1, N: 1, Mu: 0.42, FactorγH: 1.12}
+		if bd == bolt.D12 {
+			// not tabulated in table G.8 SP 16.13330.2017
+			if err := sf.Validate(); err == nil {
+				t.Errorf("bolt %s is accepted", bd)
+			}
+			continue
+		}
+		if err := sf.Validate(); err != nil {
+			t.Error(err)
+		}
+		if f, _ := sf.Value(10e3, 0.0, bolt.NoView); math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			t.Errorf("not valid factor %v for %s", f, bd)
+		}
+	}
+	for _, sf := range []bolt.SP16Friction{
+		{D: bolt.Diameter(14e-3), K: 1, N: 1, Mu: 0.42, FactorγH: 1.12},
+		{D: bolt.D24, K: 1, N: 1, Mu: 0.42},
+	} {
+		if err := sf.Validate(); err == nil {
+			t.Errorf("not valid friction connection is accepted: %#v", sf)
+		}
+		if f, _ := sf.Value(10e3, 0.0, bolt.NoView); !math.IsInf(float64(f), 1) {
+			t.Errorf("not valid friction connection is not failed: %v", f)
+		}
+	}
+}
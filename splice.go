@@ -0,0 +1,235 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// FactorβLf - reduction factor for long joints in according to
+// 3.8 EN1993-1-8.
+// Lj - distance between the centres of the end bolts. Unit - meter
+func FactorβLf(b Bolt, Lj Dimension) Factor {
+	d := float64(b.D())
+	βLf := 1.0 - (float64(Lj)-15.0*d)/(200.0*d)
+	return Factor(math.Max(0.75, math.Min(1.0, βLf)))
+}
+
+// FactorβP - reduction factor for bolts transmitting load through
+// packings in according to 3.6.1(12) EN1993-1-8.
+// tp - thickness of the packing. Unit - meter
+func FactorβP(b Bolt, tp Dimension) Factor {
+	d := float64(b.D())
+	if float64(tp) <= d/3.0 {
+		return 1.0
+	}
+	return Factor(math.Min(1.0, 9.0*d/(8.0*d+3.0*float64(tp))))
+}
+
+// Splice - bolted cover plate splice of symmetrical I-section member.
+// Flanges are spliced by outer cover plates and optionally by inner cover
+// plates (double shear). Web is spliced by two cover plates (double shear).
+// Moment is proportioned between flanges and web by its stiffness, axial
+// force is proportioned by area.
+// Steel and SteelP take precedence over Fy, Fu and FyP, FuP. Strength of
+// flange cover plates is taken for the thickest plate.
+// Unit - meter, Pa
+type Splice struct {
+	B        Bolt
+	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	Units    Units         // units of report, SI units if empty
+
+	// Member
+	H     Dimension // depth of section
+	Bf    Dimension // width of flange
+	Tf    Dimension // thickness of flange
+	Tw    Dimension // thickness of web
+	Steel Steel     // steel grade of member
+	Fy    Stress    // yield strength of member
+	Fu    Stress    // ultimate tensile strength of member
+
+	// Cover plates
+	SteelP Steel  // steel grade of cover plates
+	FyP    Stress // yield strength of cover plates
+	FuP    Stress // ultimate tensile strength of cover plates
+
+	// Flange splice
+	Bo    Dimension // width of outer flange cover plate
+	To    Dimension // thickness of outer flange cover plate
+	Bi    Dimension // width of each inner flange cover plate
+	Ti    Dimension // thickness of inner flange cover plates, zero if not exist
+	Tpack Dimension // thickness of packing between flange and cover plate
+	N1    int       // amount of bolt rows along member on each side of joint
+	P1    Dimension // spacing of bolt rows along member
+	N2    int       // amount of bolt lines across flange
+	P2    Dimension // spacing of bolt lines across flange
+	E1    Dimension // end distance on flange and cover plates
+	E2    Dimension // edge distance on flange and cover plates
+
+	// Web splice
+	Hwp Dimension // depth of web cover plates
+	Twp Dimension // thickness of each web cover plate
+	N1w int       // amount of bolt rows (vertical) in web
+	P1w Dimension // vertical spacing of bolt rows in web
+	N2w int       // amount of bolt lines (horizontal) in web on each side of joint
+	P2w Dimension // horizontal spacing of bolt lines in web
+	E1w Dimension // vertical end distance on web cover plates
+	E2w Dimension // horizontal end distance on web and web cover plates
+	Zw  Dimension // distance from joint to centroid of web bolt group
+}
+
+// sections - return area of section, area of one flange, area of web,
+// second moment of area of section and of web
+func (sp Splice) sections() (a, af, aw, i, iw float64) {
+	h, bf, tf, tw := float64(sp.H), float64(sp.Bf), float64(sp.Tf), float64(sp.Tw)
+	hw := h - 2.0*tf
+	af = bf * tf
+	aw = hw * tw
+	a = 2.0*af + aw
+	iw = tw * math.Pow(hw, 3) / 12.0
+	i = bf*math.Pow(h, 3)/12.0 - (bf-tw)*math.Pow(hw, 3)/12.0
+	return
+}
+
+// Forces - return force in flange, axial force and moment in web
+// for member loaded by axial force NEd (positive in tension) and
+// bending moment MEd.
+func (sp Splice) Forces(NEd Force, MEd Moment) (Ff, Nw Force, Mw Moment) {
+	a, af, aw, i, iw := sp.sections()
+	Mw = Moment(float64(MEd) * iw / i)
+	Nw = Force(float64(NEd) * aw / a)
+	Ff = Force(math.Abs(float64(MEd)-float64(Mw))/(float64(sp.H)-float64(sp.Tf)) +
+		math.Abs(float64(NEd))*af/a)
+	return
+}
+
+// Checks - return report of all design checks for axial force NEd
+// (positive in tension), bending moment MEd and shear force VEd.
+// Error is returned for not valid strength of member or cover plates.
+func (sp Splice) Checks(NEd Force, MEd Moment, VEd Force) (r Report, err error) {
+	Ff, Nw, Mw := sp.Forces(NEd, MEd)
+	do := float64(sp.B.Do().Value())
+	fvRd := ShearResistance{NA: sp.NA, B: sp.B, Position: sp.Position}.Value()
+	fuFlange, errFlange := sp.Steel.ultimate(sp.Tf, sp.Fu)
+	fuWeb, errWeb := sp.Steel.ultimate(sp.Tw, sp.Fu)
+	fyCover, fuCover, errCover := sp.SteelP.strength(Dimension(math.Max(float64(sp.To), float64(sp.Ti))), sp.FyP, sp.FuP)
+	fyWebCover, fuWebCover, errWebCover := sp.SteelP.strength(sp.Twp, sp.FyP, sp.FuP)
+	switch {
+	case errFlange != nil:
+		err = fmt.Errorf("flange: %v", errFlange)
+	case errWeb != nil:
+		err = fmt.Errorf("web: %v", errWeb)
+	case errCover != nil:
+		err = fmt.Errorf("flange cover plates: %v", errCover)
+	case errWebCover != nil:
+		err = fmt.Errorf("web cover plates: %v", errWebCover)
+	}
+
+	// flange bolts
+	n := float64(sp.N1 * sp.N2)
+	planes := 1.0
+	if sp.Ti > 0.0 {
+		planes = 2.0
+	}
+	βLf := FactorβLf(sp.B, Dimension(float64(sp.N1-1)*float64(sp.P1)))
+	βp := FactorβP(sp.B, sp.Tpack)
+	fEd := Force(float64(Ff) / n)
+	r = append(r, Check{
+		Name: "Flange bolt shear, 3.6, 3.8 and table 3.4 EN1993-1-8",
+		Ed:   fEd,
+		Rd:   Force(planes * float64(βLf) * float64(βp) * float64(fvRd)),
+	})
+	r = append(r, Check{
+		Name: "Flange bearing, table 3.4 EN1993-1-8",
+		Ed:   fEd,
+		Rd: BearingResistance{NA: sp.NA, B: sp.B, Thk: sp.Tf, Fu: fuFlange,
+			E1: sp.E1, P1: sp.P1, E2: sp.E2, P2: sp.P2}.Value(),
+	})
+	r = append(r, Check{
+		Name: "Flange cover plates bearing, table 3.4 EN1993-1-8",
+		Ed:   fEd,
+		Rd: BearingResistance{NA: sp.NA, B: sp.B, Thk: sp.To + sp.Ti, Fu: fuCover,
+			E1: sp.E1, P1: sp.P1, E2: sp.E2, P2: sp.P2}.Value(),
+	})
+
+	// flange cover plates and flange net section
+	ap := float64(sp.Bo)*float64(sp.To) + 2.0*float64(sp.Bi)*float64(sp.Ti)
+	apNet := ap - float64(sp.N2)*do*(float64(sp.To)+float64(sp.Ti))
+	r = append(r, Check{
+		Name: "Flange cover plates gross section, 6.2.3 EN1993-1-1",
+		Ed:   Ff,
+		Rd:   Force(ap * float64(fyCover) / float64(sp.NA.get().FactorγM0)),
+	})
+	r = append(r, Check{
+		Name: "Flange cover plates net section, 6.2.3 EN1993-1-1",
+		Ed:   Ff,
+		Rd:   Force(0.9 * apNet * float64(fuCover) / float64(sp.NA.get().FactorγM2)),
+	})
+	r = append(r, Check{
+		Name: "Member flange net section, 6.2.3 EN1993-1-1",
+		Ed:   Ff,
+		Rd: Force(0.9 * (float64(sp.Bf) - float64(sp.N2)*do) * float64(sp.Tf) *
+			float64(fuFlange) / float64(sp.NA.get().FactorγM2)),
+	})
+
+	// web bolts in double shear
+	fv, fh := boltGroupForces(sp.N1w, sp.N2w, sp.P1w, sp.P2w, VEd, Nw,
+		Moment(math.Abs(float64(Mw))+math.Abs(float64(VEd)*float64(sp.Zw))))
+	r = append(r, Check{
+		Name: "Web bolt shear with eccentricity, table 3.4 EN1993-1-8",
+		Ed:   Force(math.Hypot(float64(fv), float64(fh))),
+		Rd:   2.0 * fvRd,
+	})
+	ed, rd := bearingInteraction(fv, fh,
+		BearingResistance{NA: sp.NA, B: sp.B, Thk: sp.Tw, Fu: fuWeb, P1: sp.P1w, E2: sp.E2w, P2: sp.P2w},
+		BearingResistance{NA: sp.NA, B: sp.B, Thk: sp.Tw, Fu: fuWeb, E1: sp.E2w, P1: sp.P2w, P2: sp.P1w})
+	r = append(r, Check{Name: "Web bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+	ed, rd = bearingInteraction(fv, fh,
+		BearingResistance{NA: sp.NA, B: sp.B, Thk: 2.0 * sp.Twp, Fu: fuWebCover, E1: sp.E1w, P1: sp.P1w, E2: sp.E2w, P2: sp.P2w},
+		BearingResistance{NA: sp.NA, B: sp.B, Thk: 2.0 * sp.Twp, Fu: fuWebCover, E1: sp.E2w, P1: sp.P2w, E2: sp.E1w, P2: sp.P1w})
+	r = append(r, Check{Name: "Web cover plates bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// web cover plates and web net section in shear
+	r = append(r, Check{
+		Name: "Web cover plates shear of gross section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force(2.0 * float64(sp.Hwp) * float64(sp.Twp) * float64(fyWebCover) /
+			(math.Sqrt(3.0) * float64(sp.NA.get().FactorγM0))),
+	})
+	r = append(r, Check{
+		Name: "Web cover plates shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force(2.0 * (float64(sp.Hwp) - float64(sp.N1w)*do) * float64(sp.Twp) *
+			float64(fuWebCover) / (math.Sqrt(3.0) * float64(sp.NA.get().FactorγM2))),
+	})
+	r = append(r, Check{
+		Name: "Member web shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force((float64(sp.H) - 2.0*float64(sp.Tf) - float64(sp.N1w)*do) *
+			float64(sp.Tw) * float64(fuWeb) / (math.Sqrt(3.0) * float64(sp.NA.get().FactorγM2))),
+	})
+	return
+}
+
+// Value - return result of splice calculation for axial force NEd
+// (positive in tension), bending moment MEd and shear force VEd
+func (sp Splice) Value(NEd Force, MEd Moment, VEd Force, view ViewResult) (_ Factor, s string) {
+	u := sp.Units.get()
+	r, err := sp.Checks(NEd, MEd, VEd)
+	if err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of cover plate splice with bolts %s is not valid: %v\n", sp.B, err)
+	}
+	if view == FullView {
+		Ff, Nw, Mw := sp.Forces(NEd, MEd)
+		s += u.Sprintf("Calculation of cover plate splice with bolts %s:\n", sp.B)
+		s += u.Sprintf("\tγM0 = %s\n", sp.NA.get().FactorγM0)
+		s += u.Sprintf("\tγM2 = %s\n", sp.NA.get().FactorγM2)
+		s += u.Sprintf("\tβLf = %s\n", FactorβLf(sp.B, Dimension(float64(sp.N1-1)*float64(sp.P1))))
+		s += u.Sprintf("\tβp  = %s\n", FactorβP(sp.B, sp.Tpack))
+		s += u.Sprintf("\tNEd = %s, MEd = %s, VEd = %s\n", NEd, MEd, VEd)
+		s += u.Sprintf("\tForce in flange is %s\n", Ff)
+		s += u.Sprintf("\tForces in web are %s and %s\n", Nw, Mw)
+		s += r.In(u)
+	}
+	return r.Value(), s
+}
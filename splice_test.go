@@ -0,0 +1,96 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func splice() bolt.Splice {
+	return bolt.Splice{
+		B:        bolt.New(bolt.D20, bolt.G10p9),
+		Position: bolt.ThreadShear,
+		H:        300e-3,
+		Bf:       300e-3,
+		Tf:       19e-3,
+		Tw:       11e-3,
+		Fy:       355e6,
+		Fu:       470e6,
+		FyP:      355e6,
+		FuP:      470e6,
+		Bo:       300e-3,
+		To:       12e-3,
+		Bi:       120e-3,
+		Ti:       12e-3,
+		N1:       3,
+		P1:       70e-3,
+		N2:       2,
+		P2:       180e-3,
+		E1:       40e-3,
+		E2:       60e-3,
+		Hwp:      200e-3,
+		Twp:      8e-3,
+		N1w:      3,
+		P1w:      70e-3,
+		N2w:      1,
+		E1w:      30e-3,
+		E2w:      40e-3,
+		Zw:       50e-3,
+	}
+}
+
+func ExampleSplice() {
+	sp := splice()
+	_, s := sp.Value(-200e3, 100e3, 100e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of cover plate splice with bolts HM20Cl10.9:
+	// 	γM0 = 1.000
+	// 	γM2 = 1.250
+	// 	βLf = 1.000
+	// 	βp  = 1.000
+	// 	NEd = -200.0 kN, MEd = 100.0 kN*m, VEd = 100.0 kN
+	// 	Force in flange is 411.4 kN
+	// 	Forces in web are -40.4 kN and 6.8 kN*m
+	// 	Flange bolt shear, 3.6, 3.8 and table 3.4 EN1993-1-8: Ed = 68.6 kN, Rd = 196.0 kN, factor 0.350
+	// 	Flange bearing, table 3.4 EN1993-1-8: Ed = 68.6 kN, Rd = 216.5 kN, factor 0.317
+	// 	Flange cover plates bearing, table 3.4 EN1993-1-8: Ed = 68.6 kN, Rd = 273.5 kN, factor 0.251
+	// 	Flange cover plates gross section, 6.2.3 EN1993-1-1: Ed = 411.4 kN, Rd = 2300.4 kN, factor 0.179
+	// 	Flange cover plates net section, 6.2.3 EN1993-1-1: Ed = 411.4 kN, Rd = 1835.5 kN, factor 0.224
+	// 	Member flange net section, 6.2.3 EN1993-1-1: Ed = 411.4 kN, Rd = 1646.0 kN, factor 0.250
+	// 	Web bolt shear with eccentricity, table 3.4 EN1993-1-8: Ed = 103.4 kN, Rd = 196.0 kN, factor 0.527
+	// 	Web bearing, table 3.4 EN1993-1-8: Ed = 103.4 kN, Rd = 128.3 kN, factor 0.806
+	// 	Web cover plates bearing, table 3.4 EN1993-1-8: Ed = 103.4 kN, Rd = 152.3 kN, factor 0.679
+	// 	Web cover plates shear of gross section, 6.2.6 EN1993-1-1: Ed = 100.0 kN, Rd = 655.9 kN, factor 0.152
+	// 	Web cover plates shear of net section, 6.2.6 EN1993-1-1: Ed = 100.0 kN, Rd = 465.4 kN, factor 0.215
+	// 	Member web shear of net section, 6.2.6 EN1993-1-1: Ed = 100.0 kN, Rd = 468.0 kN, factor 0.214
+	// Summary factor is 0.806
+}
+
+func TestSplice(t *testing.T) {
+	sp := splice()
+	if f, _ := sp.Value(0.0, 0.0, 0.0, bolt.NoView); float64(f) != 0.0 {
+		t.Errorf("Factor can not be not zero if load is zero")
+	}
+	if f, _ := sp.Value(0.0, 1e10, 0.0, bolt.NoView); float64(f) < 1.0 {
+		t.Errorf("Factor can not be less 1.0 if load is huge")
+	}
+	Ff, Nw, _ := sp.Forces(1e6, 0.0)
+	if math.Abs(float64(2*Ff+Nw)-1e6) > 1e-6 {
+		t.Errorf("Axial force is not proportioned: %s %s", Ff, Nw)
+	}
+}
+
+func TestReductionFactors(t *testing.T) {
+	b := bolt.New(bolt.D20, bolt.G8p8)
+	if bolt.FactorβLf(b, 0.1) != 1.0 || bolt.FactorβLf(b, 10.0) != 0.75 {
+		t.Errorf("Not valid limits of long joint factor")
+	}
+	if bolt.FactorβP(b, 5e-3) != 1.0 || float64(bolt.FactorβP(b, 20e-3)) >= 1.0 {
+		t.Errorf("Not valid packing factor")
+	}
+}
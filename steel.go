@@ -0,0 +1,158 @@
+package bolt
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Steel - grade of structural steel
+type Steel string
+
+// Typical steel grades in according to EN10025
+const (
+	S235  Steel = "S235"
+	S275  Steel = "S275"
+	S355  Steel = "S355"
+	S450  Steel = "S450"
+	S275N Steel = "S275N"
+	S355N Steel = "S355N"
+	S420N Steel = "S420N"
+	S460N Steel = "S460N"
+	S275M Steel = "S275M"
+	S355M Steel = "S355M"
+	S420M Steel = "S420M"
+	S460M Steel = "S460M"
+	S235W Steel = "S235W"
+	S355W Steel = "S355W"
+	S460Q Steel = "S460Q"
+)
+
+// SteelStrength - strength of steel for thickness up to limit
+type SteelStrength struct {
+	Thk Dimension // maximal nominal thickness of element
+	Fy  Stress    // yield strength
+	Fu  Stress    // ultimate tensile strength
+}
+
+// steelGrade - standard and thickness-dependent strength of steel grade
+type steelGrade struct {
+	standard  string
+	strengths []SteelStrength
+}
+
+// strength - return strength of steel for thickness up to 40 mm and
+// from 40 mm up to 80 mm in MPa
+func strength(fy40, fu40, fy80, fu80 float64) []SteelStrength {
+	return []SteelStrength{
+		{Thk: 40e-3, Fy: Stress(fy40 * 1e6), Fu: Stress(fu40 * 1e6)},
+		{Thk: 80e-3, Fy: Stress(fy80 * 1e6), Fu: Stress(fu80 * 1e6)},
+	}
+}
+
+// steels - table of steel grades in according to table 3.1 EN1993-1-1
+var steels = map[Steel]steelGrade{
+	S235:  {standard: "EN10025-2", strengths: strength(235, 360, 215, 360)},
+	S275:  {standard: "EN10025-2", strengths: strength(275, 430, 255, 410)},
+	S355:  {standard: "EN10025-2", strengths: strength(355, 510, 335, 470)},
+	S450:  {standard: "EN10025-2", strengths: strength(440, 550, 410, 550)},
+	S275N: {standard: "EN10025-3", strengths: strength(275, 390, 255, 370)},
+	S355N: {standard: "EN10025-3", strengths: strength(355, 490, 335, 470)},
+	S420N: {standard: "EN10025-3", strengths: strength(420, 520, 390, 520)},
+	S460N: {standard: "EN10025-3", strengths: strength(460, 540, 430, 540)},
+	S275M: {standard: "EN10025-4", strengths: strength(275, 370, 255, 360)},
+	S355M: {standard: "EN10025-4", strengths: strength(355, 470, 335, 450)},
+	S420M: {standard: "EN10025-4", strengths: strength(420, 520, 390, 500)},
+	S460M: {standard: "EN10025-4", strengths: strength(460, 540, 430, 530)},
+	S235W: {standard: "EN10025-5", strengths: strength(235, 360, 215, 340)},
+	S355W: {standard: "EN10025-5", strengths: strength(355, 510, 335, 490)},
+	S460Q: {standard: "EN10025-6", strengths: strength(460, 570, 440, 550)},
+}
+
+// AddSteel store new steel grade data. Strengths must be sorted by
+// thickness.
+func AddSteel(grade Steel, standard string, strengths ...SteelStrength) {
+	steels[grade] = steelGrade{standard: standard, strengths: strengths}
+}
+
+// GetSteel - return steel grade by name
+func GetSteel(name string) (Steel, error) {
+	if _, ok := steels[Steel(name)]; !ok {
+		return "", fmt.Errorf("steel grade `%s` is not found", name)
+	}
+	return Steel(name), nil
+}
+
+// GetSteelList - list of all steel grades
+func GetSteelList() (grades []Steel) {
+	for grade := range steels {
+		grades = append(grades, grade)
+	}
+	sort.Slice(grades, func(i, j int) bool { return grades[i] < grades[j] })
+	return
+}
+
+// Standard - return standard of steel grade
+func (st Steel) Standard() string {
+	return steels[st].standard
+}
+
+// Strength - return yield strength and ultimate tensile strength of
+// steel for nominal thickness of element
+func (st Steel) Strength(thk Dimension) (fy, fu Stress, err error) {
+	sg, ok := steels[st]
+	if !ok {
+		err = fmt.Errorf("steel grade `%s` is not found", string(st))
+		return
+	}
+	for _, s := range sg.strengths {
+		if thk <= s.Thk {
+			return s.Fy, s.Fu, nil
+		}
+	}
+	err = fmt.Errorf("thickness %s is more limit of steel grade %s", thk, string(st))
+	return
+}
+
+// strength - return yield strength fy and ultimate tensile strength fu
+// of element with nominal thickness thk. Steel grade takes precedence:
+// strength of grade for thickness is returned if grade is defined,
+// otherwise declared fy and fu. Zero strength is returned with error for
+// not found grade, thickness out of range of grade or not declared
+// strength, so resistances of element are zero and its checks fail.
+func (st Steel) strength(thk Dimension, fy, fu Stress) (Stress, Stress, error) {
+	if st != "" {
+		return st.Strength(thk)
+	}
+	if fy <= 0.0 || fu <= 0.0 {
+		return 0.0, 0.0, fmt.Errorf("strength of steel is not declared: fy = %s, fu = %s", fy, fu)
+	}
+	return fy, fu, nil
+}
+
+// yield - return yield strength of element, see strength
+func (st Steel) yield(thk Dimension, fy Stress) (Stress, error) {
+	if st != "" {
+		fy, _, err := st.Strength(thk)
+		return fy, err
+	}
+	if fy <= 0.0 {
+		return 0.0, fmt.Errorf("yield strength of steel is not declared")
+	}
+	return fy, nil
+}
+
+// ultimate - return ultimate tensile strength of element, see strength
+func (st Steel) ultimate(thk Dimension, fu Stress) (Stress, error) {
+	if st != "" {
+		_, fu, err := st.Strength(thk)
+		return fu, err
+	}
+	if fu <= 0.0 {
+		return 0.0, fmt.Errorf("ultimate tensile strength of steel is not declared")
+	}
+	return fu, nil
+}
+
+func (st Steel) String() string {
+	return fmt.Sprintf("%s %s", string(st), st.Standard())
+}
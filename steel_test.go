@@ -0,0 +1,217 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleSteel() {
+	for _, thk := range []bolt.Dimension{20e-3, 60e-3} {
+		fy, fu, err := bolt.S355.Strength(thk)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Fprintf(os.Stdout, "%s, t = %s: fy = %s, fu = %s\n", bolt.S355, thk, fy, fu)
+	}
+
+	// Output:
+	// S355 EN10025-2, t = 20.0 mm: fy = 355.0 MPa, fu = 510.0 MPa
+	// S355 EN10025-2, t = 60.0 mm: fy = 335.0 MPa, fu = 470.0 MPa
+}
+
+func TestSteel(t *testing.T) {
+	for _, grade := range bolt.GetSteelList() {
+		st, err := bolt.GetSteel(string(grade))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fy40, fu40, err := st.Strength(40e-3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fy80, fu80, err := st.Strength(80e-3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !(fy80 < fy40 && fu80 <= fu40 && fy40 < fu40) {
+			t.Errorf("not valid strength of %s", st)
+		}
+		if _, _, err := st.Strength(100e-3); err == nil {
+			t.Errorf("strength of %s for thickness more limit is found", st)
+		}
+	}
+	if _, err := bolt.GetSteel("S999"); err == nil {
+		t.Errorf("not valid steel grade is found")
+	}
+}
+
+func TestAddSteel(t *testing.T) {
+	bolt.AddSteel("S690Q", "EN10025-6",
+		bolt.SteelStrength{Thk: 50e-3, Fy: 690e6, Fu: 770e6},
+		bolt.SteelStrength{Thk: 80e-3, Fy: 650e6, Fu: 760e6},
+	)
+	st, err := bolt.GetSteel("S690Q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fy, _, _ := st.Strength(60e-3); fy != 650e6 {
+		t.Errorf("not valid yield strength %s", fy)
+	}
+	// steel grade of tension member
+	tm := tensionMember()
+	tm.Steel = bolt.S235
+	if tm.NplRd() != tensionMember().NplRd() {
+		t.Errorf("not valid resistance of member with steel grade")
+	}
+}
+
+func TestSteelNotValid(t *testing.T) {
+	for _, tc := range []struct {
+		grade bolt.Steel
+		thk   bolt.Dimension
+	}{
+		{grade: "S355JR", thk: 10e-3},
+		{grade: bolt.S355, thk: 100e-3},
+	} {
+		tm := tensionMember()
+		tm.Steel, tm.Thk = tc.grade, tc.thk
+		if _, _, err := tm.Strength(); err == nil {
+			t.Errorf("%s, t = %s: strength of member without error", tc.grade, tc.thk)
+		}
+		if f, s := tm.Value(100e3, bolt.NoView); !math.IsInf(float64(f), 1) || s == "" {
+			t.Errorf("%s, t = %s: tension member is not failed: %s", tc.grade, tc.thk, f)
+		}
+	}
+	tm := tensionMember()
+	tm.Fy = 0.0
+	if f, _ := tm.Value(100e3, bolt.NoView); !math.IsInf(float64(f), 1) {
+		t.Errorf("tension member without yield strength is not failed: %s", f)
+	}
+
+	fp := finPlate()
+	fp.Steel = "S355JR"
+	if f, s := fp.Value(100e3, bolt.NoView); !math.IsInf(float64(f), 1) || s == "" {
+		t.Errorf("fin plate is not failed: %s", f)
+	}
+	if _, err := fp.Checks(100e3); err == nil {
+		t.Errorf("checks of fin plate without error")
+	}
+	wc := webCleat()
+	wc.SteelWeb = "S355JR"
+	if f, s := wc.Value(100e3, bolt.NoView); !math.IsInf(float64(f), 1) || s == "" {
+		t.Errorf("web cleat is not failed: %s", f)
+	}
+	if _, err := wc.Checks(100e3); err == nil {
+		t.Errorf("checks of web cleat without error")
+	}
+	sp := splice()
+	sp.SteelP = "S355JR"
+	if f, s := sp.Value(100e3, 0.0, 0.0, bolt.NoView); !math.IsInf(float64(f), 1) || s == "" {
+		t.Errorf("splice is not failed: %s", f)
+	}
+	if _, err := sp.Checks(100e3, 0.0, 0.0); err == nil {
+		t.Errorf("checks of splice without error")
+	}
+	bp := basePlate()
+	bp.Steel = "S355JR"
+	if f, _ := bp.Value(-100e3, 0.0, bolt.NoView); !math.IsInf(float64(f), 1) {
+		t.Errorf("base plate is not failed: %s", f)
+	}
+	lp := bolt.LayoutPlate{B: bolt.New(bolt.D20, bolt.G8p8), H: 300e-3, W: 150e-3, Thk: 12e-3, Steel: "S355JR"}
+	if _, err := lp.Layout(100e3, 0.0, 0.0); err == nil {
+		t.Errorf("layout for not valid steel grade is found")
+	}
+	c := bolt.Constraints{Thk: 12e-3, Steel: "S355JR"}
+	if _, err := bolt.Select(10e3, 0.0, bolt.ThreadShear, bolt.UsuallyBolt, c, bolt.ByWeight); err == nil {
+		t.Errorf("selection for not valid steel grade without error")
+	}
+}
+
+func TestSteelOfPlates(t *testing.T) {
+	b := bolt.New(bolt.D20, bolt.G8p8)
+	br := bolt.BearingResistance{B: b, Thk: 50e-3, Fu: 470e6, E1: 40e-3, E2: 40e-3}
+	brSteel := br
+	brSteel.Fu, brSteel.Steel = 0.0, bolt.S355
+	if br.Value() != brSteel.Value() {
+		t.Errorf("bearing resistance: %s != %s", br.Value(), brSteel.Value())
+	}
+	bt := bolt.BlockTearing{Ant: 1e-3, Anv: 2e-3, Thk: 12e-3, Fy: 275e6, Fu: 430e6}
+	btSteel := bt
+	btSteel.Fy, btSteel.Fu, btSteel.Steel = 0.0, 0.0, bolt.S275
+	if bt.Value() != btSteel.Value() {
+		t.Errorf("block tearing resistance: %s != %s", bt.Value(), btSteel.Value())
+	}
+
+	fp := finPlate()
+	fp.Fu, fp.FuWeb = 430e6, 430e6
+	fpSteel := fp
+	fpSteel.Fy, fpSteel.Fu, fpSteel.Steel = 0.0, 0.0, bolt.S275
+	fpSteel.FyWeb, fpSteel.FuWeb, fpSteel.SteelWeb = 0.0, 0.0, bolt.S275
+	r, _ := fp.Checks(200e3)
+	rSteel, _ := fpSteel.Checks(200e3)
+	if f, fs := r.Value(), rSteel.Value(); f != fs {
+		t.Errorf("fin plate: %s != %s", f, fs)
+	}
+
+	wc := webCleat()
+	wc.Fu, wc.FuWeb = 430e6, 430e6
+	wcSteel := wc
+	wcSteel.Fy, wcSteel.Fu, wcSteel.Steel = 0.0, 0.0, bolt.S275
+	wcSteel.FyWeb, wcSteel.FuWeb, wcSteel.SteelWeb = 0.0, 0.0, bolt.S275
+	r, _ = wc.Checks(150e3)
+	rSteel, _ = wcSteel.Checks(150e3)
+	if f, fs := r.Value(), rSteel.Value(); f != fs {
+		t.Errorf("web cleat: %s != %s", f, fs)
+	}
+
+	sp := splice()
+	sp.Fu, sp.FyP, sp.FuP = 510e6, 355e6, 510e6
+	spSteel := sp
+	spSteel.Fy, spSteel.Fu, spSteel.Steel = 0.0, 0.0, bolt.S355
+	spSteel.FyP, spSteel.FuP, spSteel.SteelP = 0.0, 0.0, bolt.S355
+	r, _ = sp.Checks(-200e3, 100e3, 100e3)
+	rSteel, _ = spSteel.Checks(-200e3, 100e3, 100e3)
+	if f, fs := r.Value(), rSteel.Value(); f != fs {
+		t.Errorf("splice: %s != %s", f, fs)
+	}
+
+	bp := basePlate()
+	bpSteel := bp
+	bpSteel.Fy, bpSteel.Steel = 0.0, bolt.S235
+	if bp.FtRd() != bpSteel.FtRd() || bp.C() != bpSteel.C() {
+		t.Errorf("base plate: %s != %s", bp.FtRd(), bpSteel.FtRd())
+	}
+
+	lp := bolt.LayoutPlate{B: b, Position: bolt.ThreadShear, H: 300e-3, W: 150e-3, Thk: 12e-3, Fu: 360e6}
+	lpSteel := lp
+	lpSteel.Fu, lpSteel.Steel = 0.0, bolt.S235
+	l, err := lp.Layout(200e3, 50e3, 15e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls, err := lpSteel.Layout(200e3, 50e3, 15e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != ls {
+		t.Errorf("layout: %s != %s", l, ls)
+	}
+
+	c := bolt.Constraints{Thk: 12e-3, Fu: 360e6}
+	cSteel := bolt.Constraints{Thk: 12e-3, Steel: bolt.S235}
+	cs, err := bolt.Select(40e3, 20e3, bolt.ThreadShear, bolt.UsuallyBolt, c, bolt.ByWeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csSteel, err := bolt.Select(40e3, 20e3, bolt.ThreadShear, bolt.UsuallyBolt, cSteel, bolt.ByWeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != len(csSteel) {
+		t.Errorf("selection: %d != %d", len(cs), len(csSteel))
+	}
+}
@@ -0,0 +1,117 @@
+package bolt
+
+import "math"
+
+// TensionMember - tension member with bolt holes in according to 6.2.3
+// EN1993-1-1. Diameter of holes is HoleDiameter of bolt.
+// Steel takes precedence over Fy and Fu.
+type TensionMember struct {
+	B         Bolt
+	Position  PositionShear
+	NA        NationalAnnex // national annex, recommended values if empty
+	Units     Units         // units of report, SI units if empty
+	A         Area          // gross area of cross-section
+	Thk       Dimension     // thickness of part with holes
+	Steel     Steel         // steel grade of member
+	Fy        Stress        // yield strength of member
+	Fu        Stress        // ultimate tensile strength of member
+	Holes     []Point       // centers of holes
+	Direction Direction     // direction of load transfer
+	CategoryC bool          // slip-resistant connection of category C
+}
+
+// Anet - return net area of cross-section for critical path of holes
+func (tm TensionMember) Anet() Area {
+	anet, _ := NetArea(tm.A, tm.Thk, tm.B, tm.Holes, tm.Direction)
+	return anet
+}
+
+// Strength - return yield strength and ultimate tensile strength of
+// member. Error is returned for not valid steel grade, thickness out of
+// range of steel grade or not declared strength.
+func (tm TensionMember) Strength() (fy, fu Stress, err error) {
+	return tm.Steel.strength(tm.Thk, tm.Fy, tm.Fu)
+}
+
+// NplRd - return design plastic resistance of the gross cross-section
+func (tm TensionMember) NplRd() Force {
+	fy, _, _ := tm.Strength()
+	return Force(float64(tm.A) * float64(fy) / float64(tm.NA.get().FactorγM0))
+}
+
+// NuRd - return design ultimate resistance of the net cross-section
+func (tm TensionMember) NuRd() Force {
+	_, fu, _ := tm.Strength()
+	return Force(0.9 * float64(tm.Anet()) * float64(fu) / float64(tm.NA.get().FactorγM2))
+}
+
+// NnetRd - return design plastic resistance of the net cross-section
+// for slip-resistant connection of category C
+func (tm TensionMember) NnetRd() Force {
+	fy, _, _ := tm.Strength()
+	return Force(float64(tm.Anet()) * float64(fy) / float64(tm.NA.get().FactorγM0))
+}
+
+// NtRd - return governing design tension resistance of member
+func (tm TensionMember) NtRd() Force {
+	nt := tm.NplRd()
+	if nu := tm.NuRd(); nu < nt {
+		nt = nu
+	}
+	if nnet := tm.NnetRd(); tm.CategoryC && nnet < nt {
+		nt = nnet
+	}
+	return nt
+}
+
+// Checks - return report of design checks for tension force NEd
+// distributed equally between bolts. Error is returned for not valid
+// strength of member.
+func (tm TensionMember) Checks(NEd Force) (r Report, err error) {
+	_, _, err = tm.Strength()
+	r = append(r, Check{
+		Name: "Plastic resistance of gross cross-section, 6.2.3 EN1993-1-1",
+		Ed:   NEd,
+		Rd:   tm.NplRd(),
+	})
+	r = append(r, Check{
+		Name: "Ultimate resistance of net cross-section, 6.2.3 EN1993-1-1",
+		Ed:   NEd,
+		Rd:   tm.NuRd(),
+	})
+	if tm.CategoryC {
+		r = append(r, Check{
+			Name: "Net cross-section of category C connection, 6.2.3 EN1993-1-1",
+			Ed:   NEd,
+			Rd:   tm.NnetRd(),
+		})
+	}
+	if n := len(tm.Holes); 0 < n {
+		r = append(r, Check{
+			Name: "Bolt shear, table 3.4 EN1993-1-8",
+			Ed:   NEd / Force(n),
+			Rd:   ShearResistance{B: tm.B, Position: tm.Position, NA: tm.NA}.Value(),
+		})
+	}
+	return
+}
+
+// Value - return result of tension member calculation
+func (tm TensionMember) Value(NEd Force, view ViewResult) (_ Factor, s string) {
+	u := tm.Units.get()
+	r, err := tm.Checks(NEd)
+	if err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of tension member with bolts %s is not valid: %v\n", tm.B, err)
+	}
+	if view == FullView {
+		s += u.Sprintf("Calculation of tension member with %d holes for bolts %s:\n", len(tm.Holes), tm.B)
+		s += u.Sprintf("\tγM0   = %s\n", tm.NA.get().FactorγM0)
+		s += u.Sprintf("\tγM2   = %s\n", tm.NA.get().FactorγM2)
+		s += u.Sprintf("\tA     = %s\n", tm.A)
+		s += u.Sprintf("\tAnet  = %s\n", tm.Anet())
+		s += u.Sprintf("\tNt,Rd = %s\n", tm.NtRd())
+		s += u.Sprintf("\tNEd   = %s\n", NEd)
+		s += r.In(u)
+	}
+	return r.Value(), s
+}
@@ -0,0 +1,73 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func tensionMember() bolt.TensionMember {
+	return bolt.TensionMember{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+		A:        200e-3 * 10e-3,
+		Thk:      10e-3,
+		Fy:       235e6,
+		Fu:       360e6,
+		Holes: []bolt.Point{
+			{X: 50e-3, Y: 40e-3},
+			{X: 50e-3, Y: 160e-3},
+			{X: 120e-3, Y: 40e-3},
+			{X: 120e-3, Y: 160e-3},
+		},
+		Direction: bolt.AlongX,
+		CategoryC: true,
+	}
+}
+
+func ExampleTensionMember() {
+	tm := tensionMember()
+	_, s := tm.Value(300e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of tension member with 4 holes for bolts HM20Cl8.8:
+	// 	γM0   = 1.000
+	// 	γM2   = 1.250
+	// 	A     = 2000.0 mm²
+	// 	Anet  = 1560.0 mm²
+	// 	Nt,Rd = 366.6 kN
+	// 	NEd   = 300.0 kN
+	// 	Plastic resistance of gross cross-section, 6.2.3 EN1993-1-1: Ed = 300.0 kN, Rd = 470.0 kN, factor 0.638
+	// 	Ultimate resistance of net cross-section, 6.2.3 EN1993-1-1: Ed = 300.0 kN, Rd = 404.4 kN, factor 0.742
+	// 	Net cross-section of category C connection, 6.2.3 EN1993-1-1: Ed = 300.0 kN, Rd = 366.6 kN, factor 0.818
+	// 	Bolt shear, table 3.4 EN1993-1-8: Ed = 75.0 kN, Rd = 94.1 kN, factor 0.797
+	// Summary factor is 0.818
+}
+
+func TestTensionMember(t *testing.T) {
+	tm := tensionMember()
+	if f, _ := tm.Value(0.0, bolt.NoView); f != 0.0 {
+		t.Errorf("Factor can not be not zero if load is zero")
+	}
+	f, _ := tm.Value(tm.NtRd(), bolt.NoView)
+	if f < 1.0-1e-9 {
+		t.Errorf("governing resistance of member is not found: %s", f)
+	}
+	r, err := tm.Checks(tm.NtRd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range r[:3] {
+		if c.Value() > 1.0+1e-9 {
+			t.Errorf("Nt,Rd is more resistance of check %s", c)
+		}
+	}
+	anet := float64(tm.A) - 2*22e-3*10e-3
+	if math.Abs(float64(tm.Anet())-anet) > 1e-12 {
+		t.Errorf("not valid net area %s", tm.Anet())
+	}
+}
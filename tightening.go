@@ -0,0 +1,167 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// KClass - k-class of bolting assembly in according to EN14399-1
+type KClass int
+
+// Constants of k-class
+const (
+	K0 KClass = iota // no requirement on k-value
+	K1               // range of individual k-values
+	K2               // mean k-value and its coefficient of variation
+)
+
+func (kc KClass) String() string {
+	return fmt.Sprintf("K%d", int(kc))
+}
+
+// TighteningMethod - method of tightening of preloaded bolts
+// in according to 8.5 EN1090-2
+type TighteningMethod int
+
+// Constants of tightening methods
+const (
+	TorqueMethod   TighteningMethod = iota // torque method, 8.5.3
+	CombinedMethod                         // combined method, 8.5.4
+	HRCMethod                              // HRC tightening method, 8.5.5
+	DTIMethod                              // direct tension indicator method, 8.5.6
+)
+
+func (tm TighteningMethod) String() string {
+	switch tm {
+	case TorqueMethod:
+		return "torque method"
+	case CombinedMethod:
+		return "combined method"
+	case HRCMethod:
+		return "HRC tightening method"
+	}
+	return "direct tension indicator method"
+}
+
+// Tightening - tightening of preloaded bolt in according to 8.5 EN1090-2.
+// Nut factor is the mean k-value of bolting assembly declared by
+// manufacturer in according to EN14399-1 and tested by ISO 16047.
+// Coefficient of variation of k-value is 0.06 if zero.
+type Tightening struct {
+	B      Bolt
+	KClass KClass
+	K      Factor // nut factor, mean k-value
+	Vk     Factor // coefficient of variation of k-value
+	Method TighteningMethod
+	T      Dimension // total nominal thickness of parts including washers
+	Units  Units     // units of report, SI units if empty
+}
+
+// FpC - return preload force in according to 8.5.1 EN1090-2
+func (t Tightening) FpC() Force {
+	return Force(0.7 * float64(t.B.Fub().Value()) * float64(t.B.As().Value()))
+}
+
+// vk - return coefficient of variation of k-value
+func (t Tightening) vk() float64 {
+	if t.Vk == 0.0 {
+		return 0.06
+	}
+	return float64(t.Vk)
+}
+
+// Validate - return error if method of tightening is not applicable for
+// bolting assembly in according to table 20 EN1090-2
+func (t Tightening) Validate() error {
+	if t.B.bc != G8p8 && t.B.bc != G10p9 {
+		return fmt.Errorf("bolt class %s is not preloaded, only classes %s and %s are allowable", t.B.bc, G8p8, G10p9)
+	}
+	allowable := map[TighteningMethod][]KClass{
+		TorqueMethod:   {K2},
+		CombinedMethod: {K1, K2},
+		HRCMethod:      {K0, K2},
+		DTIMethod:      {K0, K1, K2},
+	}
+	ok := false
+	for _, kc := range allowable[t.Method] {
+		ok = ok || kc == t.KClass
+	}
+	if !ok {
+		return fmt.Errorf("%s is not applicable for k-class %s", t.Method, t.KClass)
+	}
+	if (t.Method == TorqueMethod || t.Method == CombinedMethod) && t.K <= 0.0 {
+		return fmt.Errorf("nut factor is not declared for %s", t.Method)
+	}
+	if t.Method == CombinedMethod && 10.0*float64(t.B.D()) < float64(t.T) {
+		return fmt.Errorf("thickness %s is more 10d, turn angle must be defined by procedure test", t.T)
+	}
+	return nil
+}
+
+// Torque - return reference torque Mr for torque method and torque of
+// first step for combined method in according to 8.5.3 and 8.5.4
+// EN1090-2. Return zero for other methods.
+func (t Tightening) Torque() Moment {
+	mr := float64(t.K) * float64(t.B.D()) * float64(t.FpC())
+	switch t.Method {
+	case TorqueMethod:
+		return Moment(mr)
+	case CombinedMethod:
+		return Moment(0.75 * mr)
+	}
+	return 0.0
+}
+
+// Angle - return additional rotation of nut in degree for second step
+// of combined method in according to table 21 EN1090-2.
+// Return zero for other methods.
+func (t Tightening) Angle() float64 {
+	if t.Method != CombinedMethod {
+		return 0.0
+	}
+	d := float64(t.B.D())
+	switch {
+	case float64(t.T) < 2.0*d:
+		return 60.0
+	case float64(t.T) < 6.0*d:
+		return 90.0
+	}
+	return 120.0
+}
+
+// Scatter - return range of achieved preload. For torque method scatter
+// is defined by 5% and 95% fractiles of k-value. For other methods
+// preload is not less of FpC and is limited by yield of bolt.
+func (t Tightening) Scatter() (min, max Force) {
+	if t.Method != TorqueMethod {
+		return t.FpC(), Force(float64(t.B.Fyb().Value()) * float64(t.B.As().Value()))
+	}
+	mr := float64(t.Torque())
+	d := float64(t.B.D())
+	min = Force(mr / (d * float64(t.K) * (1.0 + 1.645*t.vk())))
+	max = Force(mr / (d * float64(t.K) * math.Max(1.0-1.645*t.vk(), 0.0)))
+	return
+}
+
+func (t Tightening) String() (s string) {
+	u := t.Units.get()
+	s += u.Sprintf("Tightening of %s by %s, k-class %s:\n", t.B, t.Method, t.KClass)
+	if err := t.Validate(); err != nil {
+		s += u.Sprintf("\tError: %v\n", err)
+		return
+	}
+	s += "\tIn according to 8.5 EN1090-2:\n"
+	s += u.Sprintf("\tFp,C = %s\n", t.FpC())
+	switch t.Method {
+	case TorqueMethod:
+		s += u.Sprintf("\tk    = %s\n", t.K)
+		s += u.Sprintf("\tMr   = %s\n", t.Torque())
+	case CombinedMethod:
+		s += u.Sprintf("\tk    = %s\n", t.K)
+		s += u.Sprintf("\tFirst step torque is %s\n", t.Torque())
+		s += u.Sprintf("\tSecond step rotation is %.0f degree\n", t.Angle())
+	}
+	min, max := t.Scatter()
+	s += u.Sprintf("\tPreload scatter is from %s to %s\n", min, max)
+	return
+}
@@ -0,0 +1,129 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleTightening() {
+	for _, method := range []bolt.TighteningMethod{
+		bolt.TorqueMethod,
+		bolt.CombinedMethod,
+		bolt.HRCMethod,
+		bolt.DTIMethod,
+	} {
+		t := bolt.Tightening{
+			B:      bolt.New(bolt.D20, bolt.G10p9),
+			KClass: bolt.K2,
+			K:      0.13,
+			Method: method,
+			T:      50e-3,
+			Units:  bolt.Units{Moment: bolt.UnitNm, Precision: 1},
+		}
+		fmt.Fprintf(os.Stdout, "%s", t)
+	}
+	t := bolt.Tightening{
+		B:      bolt.New(bolt.D20, bolt.G10p9),
+		KClass: bolt.K0,
+		Method: bolt.TorqueMethod,
+	}
+	fmt.Fprintf(os.Stdout, "%s", t)
+
+	// Output:
+	// Tightening of HM20Cl10.9 by torque method, k-class K2:
+	// 	In according to 8.5 EN1090-2:
+	// 	Fp,C = 171.5 kN
+	// 	k    = 0.130
+	// 	Mr   = 445.9 N*m
+	// 	Preload scatter is from 156.1 kN to 190.3 kN
+	// Tightening of HM20Cl10.9 by combined method, k-class K2:
+	// 	In according to 8.5 EN1090-2:
+	// 	Fp,C = 171.5 kN
+	// 	k    = 0.130
+	// 	First step torque is 334.4 N*m
+	// 	Second step rotation is 90 degree
+	// 	Preload scatter is from 171.5 kN to 220.5 kN
+	// Tightening of HM20Cl10.9 by HRC tightening method, k-class K2:
+	// 	In according to 8.5 EN1090-2:
+	// 	Fp,C = 171.5 kN
+	// 	Preload scatter is from 171.5 kN to 220.5 kN
+	// Tightening of HM20Cl10.9 by direct tension indicator method, k-class K2:
+	// 	In according to 8.5 EN1090-2:
+	// 	Fp,C = 171.5 kN
+	// 	Preload scatter is from 171.5 kN to 220.5 kN
+	// Tightening of HM20Cl10.9 by torque method, k-class K0:
+	// 	Error: torque method is not applicable for k-class K0
+}
+
+func TestTighteningAngle(t *testing.T) {
+	tcs := []struct {
+		t     bolt.Dimension
+		angle float64
+	}{
+		{t: 30e-3, angle: 60},
+		{t: 40e-3, angle: 90},
+		{t: 110e-3, angle: 90},
+		{t: 120e-3, angle: 120},
+		{t: 200e-3, angle: 120},
+	}
+	for _, tc := range tcs {
+		tg := bolt.Tightening{
+			B:      bolt.New(bolt.D20, bolt.G10p9),
+			KClass: bolt.K1,
+			K:      0.13,
+			Method: bolt.CombinedMethod,
+			T:      tc.t,
+		}
+		if err := tg.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if a := tg.Angle(); a != tc.angle {
+			t.Errorf("angle for %s is %.0f, but expect %.0f", tc.t, a, tc.angle)
+		}
+	}
+	tg := bolt.Tightening{
+		B:      bolt.New(bolt.D20, bolt.G10p9),
+		KClass: bolt.K1,
+		K:      0.13,
+		Method: bolt.CombinedMethod,
+		T:      250e-3,
+	}
+	if err := tg.Validate(); err == nil {
+		t.Errorf("thickness more 10d is not checked")
+	}
+}
+
+func TestTighteningScatter(t *testing.T) {
+	tg := bolt.Tightening{
+		B:      bolt.New(bolt.D24, bolt.G10p9),
+		KClass: bolt.K2,
+		K:      0.12,
+		Method: bolt.TorqueMethod,
+	}
+	min, max := tg.Scatter()
+	if !(min < tg.FpC() && tg.FpC() < max) {
+		t.Errorf("preload %s is not inside scatter %s - %s", tg.FpC(), min, max)
+	}
+	if mr := float64(tg.Torque()); math.Abs(mr-0.12*0.024*float64(tg.FpC())) > 1e-6 {
+		t.Errorf("not valid torque %v", mr)
+	}
+}
+
+func TestTighteningClass(t *testing.T) {
+	for _, bc := range bolt.GetBoltClassList() {
+		tg := bolt.Tightening{
+			B:      bolt.New(bolt.D20, bc),
+			KClass: bolt.K2,
+			K:      0.13,
+			Method: bolt.TorqueMethod,
+		}
+		err := tg.Validate()
+		if preloaded := bc == bolt.G8p8 || bc == bolt.G10p9; preloaded != (err == nil) {
+			t.Errorf("bolt class %s: not valid result of validation: %v", bc, err)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package bolt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Unit - unit of measurement.
+// Factor is value of one unit in SI units (N, Pa, meter, sq.meter, N*m).
+type Unit struct {
+	Name   string
+	Factor float64
+}
+
+// Units of force
+var (
+	UnitN   = Unit{Name: "N", Factor: 1.0}
+	UnitKN  = Unit{Name: "kN", Factor: 1.0e3}
+	UnitMN  = Unit{Name: "MN", Factor: 1.0e6}
+	UnitLbf = Unit{Name: "lbf", Factor: 4.4482216152605}
+	UnitKip = Unit{Name: "kip", Factor: 4.4482216152605e3}
+)
+
+// Units of stress
+var (
+	UnitPa  = Unit{Name: "Pa", Factor: 1.0}
+	UnitMPa = Unit{Name: "MPa", Factor: 1.0e6}
+	UnitGPa = Unit{Name: "GPa", Factor: 1.0e9}
+	UnitPsi = Unit{Name: "psi", Factor: 6.894757293168e3}
+	UnitKsi = Unit{Name: "ksi", Factor: 6.894757293168e6}
+)
+
+// Units of length
+var (
+	UnitM  = Unit{Name: "m", Factor: 1.0}
+	UnitMm = Unit{Name: "mm", Factor: 1.0e-3}
+	UnitIn = Unit{Name: "in", Factor: inch}
+	UnitFt = Unit{Name: "ft", Factor: 12.0 * inch}
+)
+
+// Units of area
+var (
+	UnitM2  = Unit{Name: "m²", Factor: 1.0}
+	UnitCm2 = Unit{Name: "cm²", Factor: 1.0e-4}
+	UnitMm2 = Unit{Name: "mm²", Factor: 1.0e-6}
+	UnitIn2 = Unit{Name: "in²", Factor: inch * inch}
+)
+
+// Units of moment
+var (
+	UnitNm    = Unit{Name: "N*m", Factor: 1.0}
+	UnitKNm   = Unit{Name: "kN*m", Factor: 1.0e3}
+	UnitKipIn = Unit{Name: "kip*in", Factor: 4.4482216152605e3 * inch}
+	UnitKipFt = Unit{Name: "kip*ft", Factor: 4.4482216152605e3 * 12.0 * inch}
+)
+
+// Sprint - return value in SI units formatted in unit with precision
+func (u Unit) Sprint(value float64, precision int) string {
+	return fmt.Sprintf("%.*f %s", precision, value/u.Factor, u.Name)
+}
+
+// Units - system of units for formatting of values
+type Units struct {
+	Force     Unit
+	Stress    Unit
+	Length    Unit
+	Area      Unit
+	Moment    Unit
+	Precision int // amount of digits after decimal point
+}
+
+// SI - return system of SI units used by default
+func SI() Units {
+	return Units{
+		Force:     UnitKN,
+		Stress:    UnitMPa,
+		Length:    UnitMm,
+		Area:      UnitMm2,
+		Moment:    UnitKNm,
+		Precision: 1,
+	}
+}
+
+// USCustomary - return system of US customary units
+func USCustomary() Units {
+	return Units{
+		Force:     UnitKip,
+		Stress:    UnitKsi,
+		Length:    UnitIn,
+		Area:      UnitIn2,
+		Moment:    UnitKipFt,
+		Precision: 2,
+	}
+}
+
+// get - return system of units with SI units for zero fields
+func (u Units) get() Units {
+	if u == (Units{}) {
+		return SI()
+	}
+	si := SI()
+	for _, f := range []struct {
+		v, r *Unit
+	}{
+		{&u.Force, &si.Force},
+		{&u.Stress, &si.Stress},
+		{&u.Length, &si.Length},
+		{&u.Area, &si.Area},
+		{&u.Moment, &si.Moment},
+	} {
+		if f.v.Factor == 0.0 {
+			*f.v = *f.r
+		}
+	}
+	return u
+}
+
+// In - return force formatted in system of units
+func (f Force) In(u Units) string {
+	return u.Force.Sprint(float64(f), u.Precision)
+}
+
+// In - return stress formatted in system of units
+func (s Stress) In(u Units) string {
+	return u.Stress.Sprint(float64(s), u.Precision)
+}
+
+// In - return dimension formatted in system of units
+func (d Dimension) In(u Units) string {
+	return u.Length.Sprint(float64(d), u.Precision)
+}
+
+// In - return diameter formatted in system of units
+func (dia DiameterDimension) In(u Units) string {
+	return "Ø" + u.Length.Sprint(float64(dia), u.Precision)
+}
+
+// In - return area formatted in system of units
+func (a Area) In(u Units) string {
+	return u.Area.Sprint(float64(a), u.Precision)
+}
+
+// In - return moment formatted in system of units
+func (m Moment) In(u Units) string {
+	return u.Moment.Sprint(float64(m), u.Precision)
+}
+
+// Sprintf - formats in according to a format specifier like fmt.Sprintf.
+// Arguments with method In(Units), for example Force, Stress, Dimension,
+// DiameterDimension, Area, Moment and Report, are formatted in system
+// of units.
+func (u Units) Sprintf(format string, a ...interface{}) string {
+	args := make([]interface{}, len(a))
+	for i := range a {
+		if v, ok := a[i].(interface{ In(Units) string }); ok {
+			args[i] = v.In(u)
+			continue
+		}
+		args[i] = a[i]
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// parse - return value in SI units of string with value and one of units.
+// Symbol ² in name of unit may be written as 2.
+func parse(str string, units []Unit) (value float64, err error) {
+	str = strings.TrimSpace(str)
+	var unit Unit
+	var alias, number string // longest name of unit at end of string
+	for _, u := range units {
+		for _, name := range []string{u.Name, strings.Replace(u.Name, "²", "2", -1)} {
+			if !strings.HasSuffix(str, name) || len(name) <= len(alias) {
+				continue
+			}
+			unit = u
+			alias = name
+			number = strings.TrimSpace(strings.TrimSuffix(str, name))
+		}
+	}
+	if unit.Factor == 0.0 {
+		return 0.0, fmt.Errorf("cannot find unit of value `%s`", str)
+	}
+	value, err = strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("cannot parse value `%s`: %v", str, err)
+	}
+	return value * unit.Factor, nil
+}
+
+// ParseForce - return force of string, for example: "12.5 kip"
+func ParseForce(str string) (Force, error) {
+	value, err := parse(str, []Unit{UnitN, UnitKN, UnitMN, UnitLbf, UnitKip})
+	return Force(value), err
+}
+
+// ParseStress - return stress of string, for example: "235 MPa"
+func ParseStress(str string) (Stress, error) {
+	value, err := parse(str, []Unit{UnitPa, UnitMPa, UnitGPa, UnitPsi, UnitKsi})
+	return Stress(value), err
+}
+
+// ParseDimension - return dimension of string, for example: "0.75 in"
+func ParseDimension(str string) (Dimension, error) {
+	value, err := parse(str, []Unit{UnitM, UnitMm, UnitIn, UnitFt})
+	return Dimension(value), err
+}
+
+// ParseArea - return area of string, for example: "0.334 in2"
+func ParseArea(str string) (Area, error) {
+	value, err := parse(str, []Unit{UnitM2, UnitCm2, UnitMm2, UnitIn2})
+	return Area(value), err
+}
+
+// ParseMoment - return moment of string, for example: "120 kN*m"
+func ParseMoment(str string) (Moment, error) {
+	value, err := parse(str, []Unit{UnitNm, UnitKNm, UnitKipIn, UnitKipFt})
+	return Moment(value), err
+}
@@ -0,0 +1,120 @@
+package bolt_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func ExampleUnits() {
+	b := bolt.New(bolt.D3o4in, bolt.G8p8)
+	sr := bolt.ShearResistance{B: b, Position: bolt.ThreadShear}
+	for _, u := range []bolt.Units{bolt.SI(), bolt.USCustomary()} {
+		fmt.Fprint(os.Stdout, u.Sprintf("Bolt %s: d = %s, As = %s, Fub = %s, FvRd = %s\n",
+			b, bolt.Dimension(b.D()), b.As().Value(), b.Fub().Value(), sr.Value()))
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", sr.Value().In(bolt.Units{Force: bolt.UnitLbf, Precision: 0}))
+
+	f, err := bolt.ParseForce("25 kip")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", f)
+
+	// Output:
+	// Bolt 3/4"-10UNCCl8.8: d = 19.1 mm, As = 215.8 mm², Fub = 800.0 MPa, FvRd = 82.9 kN
+	// Bolt 3/4"-10UNCCl8.8: d = 0.75 in, As = 0.33 in², Fub = 116.03 ksi, FvRd = 18.63 kip
+	// 18628 lbf
+	// 111.2 kN
+}
+
+func ExampleUnits_report() {
+	b := bolt.New(bolt.D3o4in, bolt.G8p8)
+	sr := bolt.ShearResistance{B: b, Position: bolt.ThreadShear, Units: bolt.USCustomary()}
+	fmt.Fprintf(os.Stdout, "%s\n", sr)
+
+	r := bolt.Report{{Name: "Bolt shear", Ed: 50e3, Rd: sr.Value()}}
+	fmt.Fprintf(os.Stdout, "%s", r.In(bolt.Units{Force: bolt.UnitLbf}))
+
+	// Output:
+	// Calculation of shear resistance for 3/4"-10UNCCl8.8:
+	// 	γM2 = 1.250
+	// 	αν  = 0.600 - Shear plane passes through the threaded portion of the bolt
+	// 	Fub = 116.03 ksi
+	// 	As  = 0.33 in²
+	// 	In according to table 3.4 EN1993-1-8:
+	// 	Shear resistance is 18.63 kip
+	// 	Bolt shear: Ed = 11240 lbf, Rd = 18628 lbf, factor 0.603
+	// Summary factor is 0.603
+}
+
+func TestUnitsReport(t *testing.T) {
+	fp := bolt.FinPlate{
+		B: bolt.New(bolt.D20, bolt.G8p8), N1: 3, P1: 70e-3, N2: 1, Z: 50e-3,
+		Thk: 10e-3, E1: 40e-3, E2: 40e-3, Fy: 275e6, Fu: 430e6,
+		Tw: 8e-3, E2b: 40e-3, FyWeb: 275e6, FuWeb: 430e6,
+		A: 5e-3, Factorβw: 0.85,
+	}
+	fSI, sSI := fp.Value(100e3, bolt.FullView)
+	fp.Units = bolt.USCustomary()
+	fUS, sUS := fp.Value(100e3, bolt.FullView)
+	if fSI != fUS {
+		t.Errorf("factor depends on units: %s != %s", fSI, fUS)
+	}
+	if strings.Contains(sUS, " kN") || strings.Contains(sUS, " mm") || !strings.Contains(sUS, " kip") {
+		t.Errorf("report is not in US customary units:\n%s", sUS)
+	}
+	if !strings.Contains(sSI, " kN") {
+		t.Errorf("report is not in SI units:\n%s", sSI)
+	}
+}
+
+func TestParse(t *testing.T) {
+	var (
+		force     = func(s string) (float64, error) { v, err := bolt.ParseForce(s); return float64(v), err }
+		stress    = func(s string) (float64, error) { v, err := bolt.ParseStress(s); return float64(v), err }
+		dimension = func(s string) (float64, error) { v, err := bolt.ParseDimension(s); return float64(v), err }
+		area      = func(s string) (float64, error) { v, err := bolt.ParseArea(s); return float64(v), err }
+		moment    = func(s string) (float64, error) { v, err := bolt.ParseMoment(s); return float64(v), err }
+	)
+	for _, c := range []struct {
+		str   string
+		parse func(string) (float64, error)
+		value float64
+	}{
+		{"100kN", force, 100e3},
+		{"1 MN", force, 1e6},
+		{"1 lbf", force, 4.4482216152605},
+		{"36 ksi", stress, 248.21126e6},
+		{"235 MPa", stress, 235e6},
+		{" 1 in ", dimension, 25.4e-3},
+		{"20 mm", dimension, 20e-3},
+		{"1 in2", area, 645.16e-6},
+		{"1 mm²", area, 1e-6},
+		{"5 mm2", area, 5e-6},
+		{"5 cm2", area, 5e-4},
+		{"5 m2", area, 5.0},
+		{"5 mm²", area, 5e-6},
+		{"5 cm²", area, 5e-4},
+		{"5 m²", area, 5.0},
+		{"1 kip*ft", moment, 1355.8179},
+	} {
+		v, err := c.parse(c.str)
+		if err != nil {
+			t.Errorf("%s: %v", c.str, err)
+			continue
+		}
+		if math.Abs(v-c.value) > 1e-6*math.Abs(c.value) {
+			t.Errorf("%s: %v != %v", c.str, v, c.value)
+		}
+	}
+	for _, str := range []string{"100", "kN", "1 kg", "1,0 kN"} {
+		if _, err := bolt.ParseForce(str); err == nil {
+			t.Errorf("Value `%s` is parsed", str)
+		}
+	}
+}
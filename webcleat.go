@@ -0,0 +1,178 @@
+package bolt
+
+import (
+	"fmt"
+	"math"
+)
+
+// WebCleat - beam-to-column double angle web cleat connection.
+// Cleats are bolted to beam web by bolts in double shear and to column
+// by bolts in single shear. Connection is loaded by vertical shear force.
+// Eccentricity moment is taken by bolt group on beam web and by bolt line
+// on each column side leg of cleats.
+// Steel and SteelWeb take precedence over Fy, Fu and FyWeb, FuWeb.
+// Unit - meter, Pa
+type WebCleat struct {
+	B        Bolt
+	Position PositionShear
+	NA       NationalAnnex // national annex, recommended values if empty
+	Units    Units         // units of report, SI units if empty
+
+	N1 int       // amount of bolt rows (vertical) on each leg
+	P1 Dimension // vertical spacing of bolt rows
+
+	// Beam side leg
+	N2 int       // amount of bolt lines (horizontal) on beam web
+	P2 Dimension // horizontal spacing of bolt lines on beam web
+	Z  Dimension // distance from heel of cleat to centroid of bolt group on beam web
+
+	// Cleat
+	Thk   Dimension // thickness of cleat
+	E1    Dimension // vertical end distance on cleat
+	E2    Dimension // horizontal edge distance on beam side leg of cleat
+	E2c   Dimension // horizontal edge distance on column side leg of cleat
+	Zc    Dimension // distance from heel of cleat to column side bolt line
+	Steel Steel     // steel grade of cleat
+	Fy    Stress    // yield strength of cleat
+	Fu    Stress    // ultimate tensile strength of cleat
+
+	// Beam web
+	Tw       Dimension // thickness of beam web
+	E2b      Dimension // horizontal edge distance on beam web
+	SteelWeb Steel     // steel grade of beam web
+	FyWeb    Stress    // yield strength of beam web
+	FuWeb    Stress    // ultimate tensile strength of beam web
+}
+
+// Hc - depth of cleat
+func (wc WebCleat) Hc() Dimension {
+	return Dimension(2.0*float64(wc.E1) + float64(wc.N1-1)*float64(wc.P1))
+}
+
+// Checks - return report of all design checks for vertical shear force VEd.
+// Error is returned for not valid strength of cleats or beam web.
+func (wc WebCleat) Checks(VEd Force) (r Report, err error) {
+	fv, fh := boltGroupForces(wc.N1, wc.N2, wc.P1, wc.P2, VEd, 0.0, Moment(float64(VEd)*float64(wc.Z)))
+	fvRd := ShearResistance{NA: wc.NA, B: wc.B, Position: wc.Position}.Value()
+	do := float64(wc.B.Do().Value())
+	hc := float64(wc.Hc())
+	t := float64(wc.Thk)
+	fy, fu, err := wc.Steel.strength(wc.Thk, wc.Fy, wc.Fu)
+	if err != nil {
+		err = fmt.Errorf("cleat: %v", err)
+	}
+	fyWeb, fuWeb, errWeb := wc.SteelWeb.strength(wc.Tw, wc.FyWeb, wc.FuWeb)
+	if err == nil && errWeb != nil {
+		err = fmt.Errorf("beam web: %v", errWeb)
+	}
+
+	// beam side bolts in double shear
+	r = append(r, Check{
+		Name: "Beam side bolt shear with eccentricity, table 3.4 EN1993-1-8",
+		Ed:   Force(math.Hypot(float64(fv), float64(fh))),
+		Rd:   2.0 * fvRd,
+	})
+
+	// beam side cleat bearing, each cleat takes half of bolt force
+	ed, rd := bearingInteraction(fv/2.0, fh/2.0,
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Thk, Steel: wc.Steel, Fu: wc.Fu, E1: wc.E1, P1: wc.P1, E2: wc.E2, P2: wc.P2},
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Thk, Steel: wc.Steel, Fu: wc.Fu, E1: wc.E2, P1: wc.P2, E2: wc.E1, P2: wc.P1})
+	r = append(r, Check{Name: "Beam side cleat bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// beam web bearing
+	ed, rd = bearingInteraction(fv, fh,
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Tw, Steel: wc.SteelWeb, Fu: wc.FuWeb, P1: wc.P1, E2: wc.E2b, P2: wc.P2},
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Tw, Steel: wc.SteelWeb, Fu: wc.FuWeb, E1: wc.E2b, P1: wc.P2, P2: wc.P1})
+	r = append(r, Check{Name: "Beam web bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// beam web shear at connection
+	r = append(r, Check{
+		Name: "Beam web shear of gross section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd:   Force(hc * float64(wc.Tw) * float64(fyWeb) / (math.Sqrt(3.0) * float64(wc.NA.get().FactorγM0))),
+	})
+	r = append(r, Check{
+		Name: "Beam web shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force((hc - float64(wc.N1)*do) * float64(wc.Tw) * float64(fuWeb) /
+			(math.Sqrt(3.0) * float64(wc.NA.get().FactorγM2))),
+	})
+
+	// column side bolts in single shear, each leg takes half of force
+	// with eccentricity from heel of cleat
+	fcv, fch := boltGroupForces(wc.N1, 1, wc.P1, 0.0, VEd/2.0, 0.0, Moment(float64(VEd)/2.0*float64(wc.Zc)))
+	r = append(r, Check{
+		Name: "Column side bolt shear with eccentricity, table 3.4 EN1993-1-8",
+		Ed:   Force(math.Hypot(float64(fcv), float64(fch))),
+		Rd:   fvRd,
+	})
+	ed, rd = bearingInteraction(fcv, fch,
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Thk, Steel: wc.Steel, Fu: wc.Fu, E1: wc.E1, P1: wc.P1, E2: wc.E2c},
+		BearingResistance{NA: wc.NA, B: wc.B, Thk: wc.Thk, Steel: wc.Steel, Fu: wc.Fu, E1: wc.E2c, E2: wc.E1, P2: wc.P1})
+	r = append(r, Check{Name: "Column side cleat bearing, table 3.4 EN1993-1-8", Ed: ed, Rd: rd})
+
+	// shear of two cleats
+	r = append(r, Check{
+		Name: "Cleat shear of gross section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd:   Force(2.0 * hc * t * float64(fy) / (math.Sqrt(3.0) * float64(wc.NA.get().FactorγM0))),
+	})
+	r = append(r, Check{
+		Name: "Cleat shear of net section, 6.2.6 EN1993-1-1",
+		Ed:   VEd,
+		Rd: Force(2.0 * (hc - float64(wc.N1)*do) * t * float64(fu) /
+			(math.Sqrt(3.0) * float64(wc.NA.get().FactorγM2))),
+	})
+
+	// block tearing of two cleats
+	anv := Area(t * (hc - float64(wc.E1) - (float64(wc.N1)-0.5)*do))
+	r = append(r, Check{
+		Name: "Beam side cleat block tearing, 3.10.2 EN1993-1-8",
+		Ed:   VEd,
+		Rd: 2.0 * BlockTearing{
+			NA: wc.NA,
+			Ant: Area(t * (float64(wc.E2) + float64(wc.N2-1)*float64(wc.P2) -
+				(float64(wc.N2)-0.5)*do)),
+			Anv:       anv,
+			Thk:       wc.Thk,
+			Steel:     wc.Steel,
+			Fy:        wc.Fy,
+			Fu:        wc.Fu,
+			Eccentric: true,
+		}.Value(),
+	})
+	r = append(r, Check{
+		Name: "Column side cleat block tearing, 3.10.2 EN1993-1-8",
+		Ed:   VEd,
+		Rd: 2.0 * BlockTearing{
+			NA:        wc.NA,
+			Ant:       Area(t * (float64(wc.E2c) - 0.5*do)),
+			Anv:       anv,
+			Thk:       wc.Thk,
+			Steel:     wc.Steel,
+			Fy:        wc.Fy,
+			Fu:        wc.Fu,
+			Eccentric: true,
+		}.Value(),
+	})
+	return
+}
+
+// Value - return result of web cleat calculation for vertical shear force VEd
+func (wc WebCleat) Value(VEd Force, view ViewResult) (_ Factor, s string) {
+	u := wc.Units.get()
+	r, err := wc.Checks(VEd)
+	if err != nil {
+		return Factor(math.Inf(1)), u.Sprintf("Calculation of double angle web cleats with bolts %s is not valid: %v\n", wc.B, err)
+	}
+	if view == FullView {
+		s += u.Sprintf("Calculation of double angle web cleats with %d bolts %s:\n", wc.N1, wc.B)
+		s += u.Sprintf("\tγM0 = %s\n", wc.NA.get().FactorγM0)
+		s += u.Sprintf("\tγM2 = %s\n", wc.NA.get().FactorγM2)
+		s += u.Sprintf("\thc  = %s\n", wc.Hc())
+		s += u.Sprintf("\tt   = %s\n", wc.Thk)
+		s += u.Sprintf("\tVEd = %s\n", VEd)
+		s += r.In(u)
+	}
+	return r.Value(), s
+}
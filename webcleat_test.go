@@ -0,0 +1,95 @@
+package bolt_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Konstantin8105/bolt"
+)
+
+func webCleat() bolt.WebCleat {
+	return bolt.WebCleat{
+		B:        bolt.New(bolt.D20, bolt.G8p8),
+		Position: bolt.ThreadShear,
+		N1:       3,
+		P1:       70e-3,
+		N2:       1,
+		Z:        50e-3,
+		Thk:      10e-3,
+		E1:       40e-3,
+		E2:       40e-3,
+		E2c:      40e-3,
+		Zc:       50e-3,
+		Fy:       275e6,
+		Fu:       410e6,
+		Tw:       8.6e-3,
+		E2b:      40e-3,
+		FyWeb:    275e6,
+		FuWeb:    410e6,
+	}
+}
+
+func ExampleWebCleat() {
+	wc := webCleat()
+	_, s := wc.Value(150e3, bolt.FullView)
+	fmt.Fprintf(os.Stdout, "%s", s)
+
+	// Output:
+	// Calculation of double angle web cleats with 3 bolts HM20Cl8.8:
+	// 	γM0 = 1.000
+	// 	γM2 = 1.250
+	// 	hc  = 220.0 mm
+	// 	t   = 10.0 mm
+	// 	VEd = 150.0 kN
+	// 	Beam side bolt shear with eccentricity, table 3.4 EN1993-1-8: Ed = 73.3 kN, Rd = 188.2 kN, factor 0.389
+	// 	Beam side cleat bearing, table 3.4 EN1993-1-8: Ed = 36.6 kN, Rd = 99.4 kN, factor 0.369
+	// 	Beam web bearing, table 3.4 EN1993-1-8: Ed = 73.3 kN, Rd = 95.9 kN, factor 0.764
+	// 	Beam web shear of gross section, 6.2.6 EN1993-1-1: Ed = 150.0 kN, Rd = 300.4 kN, factor 0.499
+	// 	Beam web shear of net section, 6.2.6 EN1993-1-1: Ed = 150.0 kN, Rd = 250.8 kN, factor 0.598
+	// 	Column side bolt shear with eccentricity, table 3.4 EN1993-1-8: Ed = 36.6 kN, Rd = 94.1 kN, factor 0.389
+	// 	Column side cleat bearing, table 3.4 EN1993-1-8: Ed = 36.6 kN, Rd = 99.4 kN, factor 0.369
+	// 	Cleat shear of gross section, 6.2.6 EN1993-1-1: Ed = 150.0 kN, Rd = 698.6 kN, factor 0.215
+	// 	Cleat shear of net section, 6.2.6 EN1993-1-1: Ed = 150.0 kN, Rd = 583.3 kN, factor 0.257
+	// 	Beam side cleat block tearing, 3.10.2 EN1993-1-8: Ed = 150.0 kN, Rd = 492.0 kN, factor 0.305
+	// 	Column side cleat block tearing, 3.10.2 EN1993-1-8: Ed = 150.0 kN, Rd = 492.0 kN, factor 0.305
+	// Summary factor is 0.764
+}
+
+func TestWebCleat(t *testing.T) {
+	wc := webCleat()
+	if f, _ := wc.Value(0.0, bolt.NoView); float64(f) != 0.0 {
+		t.Errorf("Factor can not be not zero if load is zero")
+	}
+	if f, _ := wc.Value(1e10, bolt.NoView); float64(f) < 1.0 {
+		t.Errorf("Factor can not be less 1.0 if load is huge")
+	}
+	sr := bolt.ShearResistance{B: wc.B, Position: wc.Position}
+	r, err := wc.Checks(1e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r[0].Rd != 2.0*sr.Value() {
+		t.Errorf("Bolts on beam side are not in double shear")
+	}
+	// eccentricity of column side bolts
+	name := "Column side bolt shear with eccentricity, table 3.4 EN1993-1-8"
+	centric := wc
+	centric.Zc = 0.0
+	var ed, edCentric bolt.Force
+	r, _ = wc.Checks(150e3)
+	for _, c := range r {
+		if c.Name == name {
+			ed = c.Ed
+		}
+	}
+	r, _ = centric.Checks(150e3)
+	for _, c := range r {
+		if c.Name == name {
+			edCentric = c.Ed
+		}
+	}
+	if edCentric != 150e3/bolt.Force(2*wc.N1) || ed <= edCentric {
+		t.Errorf("Eccentricity of column side bolts is not taken: %s, %s", ed, edCentric)
+	}
+}